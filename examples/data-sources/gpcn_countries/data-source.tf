@@ -0,0 +1,24 @@
+# Example: Querying GPCN Countries
+#
+# This example demonstrates how to list the countries that contain
+# datacenters, for example to build a location selection menu.
+
+terraform {
+  required_providers {
+    gpcn = {
+      source  = "Global-Private-Cloud-Network/gpcn"
+      version = "~>0.1.0"
+    }
+  }
+}
+
+provider "gpcn" {}
+
+# Example: Every country that contains datacenters
+data "gpcn_countries" "all" {}
+
+# Output the country names keyed by their abbreviation
+output "countries" {
+  description = "Names of all countries that contain datacenters, keyed by abbreviation"
+  value       = { for country in data.gpcn_countries.all.countries : country.abbreviation => country.name }
+}
@@ -0,0 +1,59 @@
+# Example: Querying the Capabilities of a GPCN Datacenter
+#
+# This example demonstrates how to look up a single datacenter and check
+# that it supports a configuration before deploying to it.
+
+terraform {
+  required_providers {
+    gpcn = {
+      source  = "Global-Private-Cloud-Network/gpcn"
+      version = "~>0.1.0"
+    }
+  }
+}
+
+provider "gpcn" {}
+
+# Lookup datacenter in East US region
+data "gpcn_datacenters" "east_us" {
+  country_name = "United States"
+  region_name  = "east"
+}
+
+# Example 1: Capabilities of a datacenter looked up by ID
+data "gpcn_datacenter" "east_us" {
+  id = data.gpcn_datacenters.east_us.datacenters[0].id
+}
+
+# Example 2: A datacenter looked up by name
+data "gpcn_datacenter" "by_name" {
+  name = data.gpcn_datacenters.east_us.datacenters[0].name
+}
+
+locals {
+  required_image       = "Alma Linux 8.x"
+  required_volume_type = "SSD"
+
+  image_names       = data.gpcn_datacenter.east_us.images[*].name
+  volume_type_names = data.gpcn_datacenter.east_us.volume_types[*].name
+}
+
+# Fail the plan when the datacenter cannot host the configuration
+check "datacenter_supports_configuration" {
+  assert {
+    condition     = contains(local.image_names, local.required_image) && contains(local.volume_type_names, local.required_volume_type)
+    error_message = "The datacenter does not support the required image or volume type."
+  }
+}
+
+# Output the sizes available for each image
+output "sizes_per_image" {
+  description = "Names of the sizes available for each image in the datacenter"
+  value       = { for image in data.gpcn_datacenter.east_us.images : image.name => image.sizes[*].name }
+}
+
+# Output the network types supported by the datacenter
+output "network_types" {
+  description = "Network types that can be created in the datacenter"
+  value       = data.gpcn_datacenter.east_us.network_types
+}
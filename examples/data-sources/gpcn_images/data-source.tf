@@ -0,0 +1,45 @@
+# Example: Querying GPCN Virtual Machine Images
+#
+# This example demonstrates how to list the operating system images available
+# in a datacenter and pick the latest one without hard-coding its name.
+
+terraform {
+  required_providers {
+    gpcn = {
+      source  = "Global-Private-Cloud-Network/gpcn"
+      version = "~>0.1.0"
+    }
+  }
+}
+
+provider "gpcn" {}
+
+# Lookup datacenter in East US region
+data "gpcn_datacenters" "east_us" {
+  country_name = "United States"
+  region_name  = "east"
+}
+
+# Example 1: The latest Ubuntu image in East US
+data "gpcn_images" "ubuntu" {
+  datacenter_id = data.gpcn_datacenters.east_us.datacenters[0].id
+  name_regex    = "^Ubuntu"
+  most_recent   = true
+}
+
+# Example 2: Every image available in East US
+data "gpcn_images" "all" {
+  datacenter_id = data.gpcn_datacenters.east_us.datacenters[0].id
+}
+
+# Output the name of the latest Ubuntu image, ready for gpcn_virtualmachine.image
+output "latest_ubuntu_image" {
+  description = "Name of the latest Ubuntu image in East US"
+  value       = data.gpcn_images.ubuntu.images[0].name
+}
+
+# Output the names of all images in East US
+output "all_image_names" {
+  description = "Names of all images available in East US"
+  value       = data.gpcn_images.all.images[*].name
+}
@@ -0,0 +1,38 @@
+# Example: Looking up a GPCN Network
+#
+# This example demonstrates how to consume a network that is managed
+# elsewhere, such as a shared platform network, by its name or its ID.
+
+terraform {
+  required_providers {
+    gpcn = {
+      source  = "Global-Private-Cloud-Network/gpcn"
+      version = "~>0.1.0"
+    }
+  }
+}
+
+provider "gpcn" {}
+
+# Lookup datacenter in East US region
+data "gpcn_datacenters" "east_us" {
+  country_name = "United States"
+  region_name  = "east"
+}
+
+# Example 1: Look up a shared network by name within a datacenter
+data "gpcn_network" "shared" {
+  name          = "platform-shared"
+  datacenter_id = data.gpcn_datacenters.east_us.datacenters[0].id
+}
+
+# Example 2: Look up a network by ID
+data "gpcn_network" "by_id" {
+  id = "c13808d9-3b7d-42c5-a21d-f0961308a38a"
+}
+
+# Output the CIDR block of the shared network
+output "shared_network_cidr_block" {
+  description = "CIDR block of the shared platform network"
+  value       = data.gpcn_network.shared.cidr_block
+}
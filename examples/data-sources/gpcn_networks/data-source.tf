@@ -0,0 +1,36 @@
+# Example: Querying GPCN Networks
+#
+# This example demonstrates how to list existing networks in a datacenter,
+# optionally narrowed down by network type.
+
+terraform {
+  required_providers {
+    gpcn = {
+      source  = "Global-Private-Cloud-Network/gpcn"
+      version = "~>0.1.0"
+    }
+  }
+}
+
+provider "gpcn" {}
+
+# Lookup datacenter in East US region
+data "gpcn_datacenters" "east_us" {
+  country_name = "United States"
+  region_name  = "east"
+}
+
+# Example 1: All standard networks in East US
+data "gpcn_networks" "standard" {
+  datacenter_id = data.gpcn_datacenters.east_us.datacenters[0].id
+  network_type  = "standard"
+}
+
+# Example 2: Every network visible to the API key
+data "gpcn_networks" "all" {}
+
+# Output a map of standard network names to CIDR blocks
+output "standard_network_cidr_blocks" {
+  description = "CIDR blocks of the standard networks in East US, keyed by name"
+  value       = { for network in data.gpcn_networks.standard.networks : network.name => network.cidr_block }
+}
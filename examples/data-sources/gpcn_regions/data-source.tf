@@ -0,0 +1,37 @@
+# Example: Querying GPCN Regions
+#
+# This example demonstrates how to list the regions that contain
+# datacenters, for example to build a location selection menu.
+
+terraform {
+  required_providers {
+    gpcn = {
+      source  = "Global-Private-Cloud-Network/gpcn"
+      version = "~>0.1.0"
+    }
+  }
+}
+
+provider "gpcn" {}
+
+# Example 1: Every region that contains datacenters
+data "gpcn_regions" "all" {}
+
+# Example 2: Only the regions in the United States
+data "gpcn_regions" "united_states" {
+  country_name = "United States"
+}
+
+# Output the regions grouped by country
+output "regions_by_country" {
+  description = "Names of the regions in each country"
+  value = {
+    for region in data.gpcn_regions.all.regions : region.country_abbreviation => region.name...
+  }
+}
+
+# Output the names of the regions in the United States
+output "united_states_region_names" {
+  description = "Names of the regions in the United States"
+  value       = data.gpcn_regions.united_states.regions[*].name
+}
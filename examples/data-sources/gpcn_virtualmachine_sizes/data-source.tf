@@ -0,0 +1,57 @@
+# Example: Querying GPCN Virtual Machine Sizes
+#
+# This example demonstrates how to select a virtual machine size by the
+# resources it provides instead of hard-coding its name.
+
+terraform {
+  required_providers {
+    gpcn = {
+      source  = "Global-Private-Cloud-Network/gpcn"
+      version = "~>0.1.0"
+    }
+  }
+}
+
+provider "gpcn" {}
+
+# Lookup datacenter in East US region
+data "gpcn_datacenters" "east_us" {
+  country_name = "United States"
+  region_name  = "east"
+}
+
+# Example 1: The smallest size with at least 4 vCPU and 8 GB of RAM
+data "gpcn_virtualmachine_sizes" "medium" {
+  datacenter_id = data.gpcn_datacenters.east_us.datacenters[0].id
+  image         = "Alma Linux 8.x"
+  min_cpu       = 4
+  min_ram       = 8
+  smallest      = true
+}
+
+# Example 2: Every size with at most 2 vCPU available for the image
+data "gpcn_virtualmachine_sizes" "small" {
+  datacenter_id = data.gpcn_datacenters.east_us.datacenters[0].id
+  image         = "Alma Linux 8.x"
+  max_cpu       = 2
+}
+
+# Use the selected size for a new virtual machine
+resource "gpcn_virtualmachine" "app" {
+  name          = "app-server"
+  datacenter_id = data.gpcn_datacenters.east_us.datacenters[0].id
+  image         = "Alma Linux 8.x"
+  size          = data.gpcn_virtualmachine_sizes.medium.sizes[0].name
+}
+
+# Output the name of the selected size
+output "medium_size" {
+  description = "Smallest size with at least 4 vCPU and 8 GB of RAM"
+  value       = data.gpcn_virtualmachine_sizes.medium.sizes[0].name
+}
+
+# Output the names of all small sizes
+output "small_size_names" {
+  description = "Names of all sizes with at most 2 vCPU"
+  value       = data.gpcn_virtualmachine_sizes.small.sizes[*].name
+}
@@ -0,0 +1,46 @@
+# Example: Querying GPCN Virtual Machines
+#
+# This example demonstrates how to look up existing virtual machines, including
+# ones created outside of this configuration. Results can drive dynamic
+# inventories or for_each over an existing fleet.
+
+terraform {
+  required_providers {
+    gpcn = {
+      source  = "Global-Private-Cloud-Network/gpcn"
+      version = "~>0.1.0"
+    }
+  }
+}
+
+provider "gpcn" {}
+
+# Lookup datacenter in East US region
+data "gpcn_datacenters" "east_us" {
+  country_name = "United States"
+  region_name  = "east"
+}
+
+# Example 1: All running web servers in East US
+data "gpcn_virtualmachines" "web" {
+  datacenter_id = data.gpcn_datacenters.east_us.datacenters[0].id
+  name_regex    = "^web-"
+  status        = "Running"
+}
+
+# Example 2: Every virtual machine using a given image
+data "gpcn_virtualmachines" "ubuntu" {
+  image = "Ubuntu 24.04"
+}
+
+# Output a map of virtual machine names to IDs
+output "web_virtualmachine_ids" {
+  description = "IDs of the running web servers in East US, keyed by name"
+  value       = { for vm in data.gpcn_virtualmachines.web.virtual_machines : vm.name => vm.id }
+}
+
+# Output count of Ubuntu virtual machines
+output "ubuntu_virtualmachine_count" {
+  description = "Number of virtual machines running Ubuntu 24.04"
+  value       = length(data.gpcn_virtualmachines.ubuntu.virtual_machines)
+}
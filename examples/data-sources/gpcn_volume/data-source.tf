@@ -0,0 +1,38 @@
+# Example: Looking up a GPCN Volume
+#
+# This example demonstrates how to look up an existing volume by its name
+# or its ID, including the virtual machine it is attached to.
+
+terraform {
+  required_providers {
+    gpcn = {
+      source  = "Global-Private-Cloud-Network/gpcn"
+      version = "~>0.1.0"
+    }
+  }
+}
+
+provider "gpcn" {}
+
+# Lookup datacenter in East US region
+data "gpcn_datacenters" "east_us" {
+  country_name = "United States"
+  region_name  = "east"
+}
+
+# Example 1: Look up a volume by name within a datacenter
+data "gpcn_volume" "database" {
+  name          = "database-storage"
+  datacenter_id = data.gpcn_datacenters.east_us.datacenters[0].id
+}
+
+# Example 2: Look up a volume by ID
+data "gpcn_volume" "by_id" {
+  id = "c13808d9-3b7d-42c5-a21d-f0961308a38a"
+}
+
+# Output the virtual machine the database volume is attached to
+output "database_volume_virtual_machine" {
+  description = "Name of the virtual machine the database volume is attached to"
+  value       = data.gpcn_volume.database.virtual_machine_name
+}
@@ -0,0 +1,51 @@
+# Example: Querying GPCN Volume Types
+#
+# This example demonstrates how to list the storage tiers available in a
+# datacenter, together with the sizes each of them can be provisioned with.
+
+terraform {
+  required_providers {
+    gpcn = {
+      source  = "Global-Private-Cloud-Network/gpcn"
+      version = "~>0.1.0"
+    }
+  }
+}
+
+provider "gpcn" {}
+
+# Lookup datacenter in East US region
+data "gpcn_datacenters" "east_us" {
+  country_name = "United States"
+  region_name  = "east"
+}
+
+# Example: Every volume type available in East US
+data "gpcn_volume_types" "east_us" {
+  datacenter_id = data.gpcn_datacenters.east_us.datacenters[0].id
+}
+
+locals {
+  # Pick the first volume type and the smallest size it offers
+  volume_type = data.gpcn_volume_types.east_us.volume_types[0]
+}
+
+# Create a volume without hard-coding the storage tier
+resource "gpcn_volume" "data" {
+  name          = "data-volume"
+  datacenter_id = data.gpcn_datacenters.east_us.datacenters[0].id
+  volume_type   = local.volume_type.name
+  size_gb       = min(local.volume_type.available_sizes_gb...)
+}
+
+# Output the names of all volume types in East US
+output "volume_type_names" {
+  description = "Names of all volume types available in East US"
+  value       = data.gpcn_volume_types.east_us.volume_types[*].name
+}
+
+# Output the sizes offered for each volume type
+output "volume_type_sizes" {
+  description = "Available sizes in GB for each volume type in East US"
+  value       = { for volume_type in data.gpcn_volume_types.east_us.volume_types : volume_type.name => volume_type.available_sizes_gb }
+}
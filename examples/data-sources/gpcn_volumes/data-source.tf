@@ -0,0 +1,38 @@
+# Example: Querying GPCN Volumes
+#
+# This example demonstrates how to list existing volumes, for example to find
+# unattached volumes that are still incurring cost.
+
+terraform {
+  required_providers {
+    gpcn = {
+      source  = "Global-Private-Cloud-Network/gpcn"
+      version = "~>0.1.0"
+    }
+  }
+}
+
+provider "gpcn" {}
+
+# Lookup datacenter in East US region
+data "gpcn_datacenters" "east_us" {
+  country_name = "United States"
+  region_name  = "east"
+}
+
+# Example 1: All unattached volumes in East US
+data "gpcn_volumes" "orphaned" {
+  datacenter_id    = data.gpcn_datacenters.east_us.datacenters[0].id
+  attachment_state = "unattached"
+}
+
+# Example 2: Every NVMe volume visible to the API key
+data "gpcn_volumes" "nvme" {
+  volume_type = "NVMe"
+}
+
+# Output the unattached volumes and their sizes
+output "orphaned_volumes" {
+  description = "Unattached volumes in East US, keyed by name, with their size in GB"
+  value       = { for volume in data.gpcn_volumes.orphaned.volumes : volume.name => volume.size_gb }
+}
@@ -0,0 +1,18 @@
+# Lists existing networks so they can be imported. Run with `terraform query`, optionally with
+# `-generate-config-out=generated.tf` to write import blocks and resource configuration for every result.
+
+# Example 1: Every network visible to the API key
+list "gpcn_network" "all" {
+  provider = gpcn
+}
+
+# Example 2: Custom networks in one datacenter
+list "gpcn_network" "custom" {
+  provider         = gpcn
+  include_resource = true
+
+  config {
+    datacenter_id = "7a3c1e52-9b0d-4f6e-8d21-3c5b7e9f0a14"
+    network_type  = "custom"
+  }
+}
@@ -0,0 +1,19 @@
+# Lists existing virtual machines so they can be imported. Run with `terraform query`, optionally with
+# `-generate-config-out=generated.tf` to write import blocks and resource configuration for every result.
+
+# Example 1: Every virtual machine visible to the API key
+list "gpcn_virtualmachine" "all" {
+  provider = gpcn
+}
+
+# Example 2: Running virtual machines in one datacenter whose name starts with "web-"
+list "gpcn_virtualmachine" "web" {
+  provider         = gpcn
+  include_resource = true
+
+  config {
+    datacenter_id = "7a3c1e52-9b0d-4f6e-8d21-3c5b7e9f0a14"
+    name_regex    = "^web-"
+    status        = "Running"
+  }
+}
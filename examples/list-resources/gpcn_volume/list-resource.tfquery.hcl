@@ -0,0 +1,19 @@
+# Lists existing volumes so they can be imported. Run with `terraform query`, optionally with
+# `-generate-config-out=generated.tf` to write import blocks and resource configuration for every result.
+
+# Example 1: Every volume visible to the API key
+list "gpcn_volume" "all" {
+  provider = gpcn
+}
+
+# Example 2: Unattached SSD volumes in one datacenter
+list "gpcn_volume" "unattached_ssd" {
+  provider         = gpcn
+  include_resource = true
+
+  config {
+    datacenter_id    = "7a3c1e52-9b0d-4f6e-8d21-3c5b7e9f0a14"
+    volume_type      = "SSD"
+    attachment_state = "unattached"
+  }
+}
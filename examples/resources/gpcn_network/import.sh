@@ -1 +1,11 @@
+# Import by ID
 terraform import gpcn_network.example_standard "c13808d9-3b7d-42c5-a21d-f0961308a38a"
+
+# Import by name, searching every datacenter
+terraform import gpcn_network.example_standard "name:terraform-demo-standard"
+
+# Import by datacenter name and name
+terraform import gpcn_network.example_standard "<datacenter_name>/terraform-demo-standard"
+
+# The same, with the optional dc: prefix
+terraform import gpcn_network.example_standard "dc:<datacenter_name>/terraform-demo-standard"
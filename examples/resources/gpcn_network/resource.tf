@@ -40,7 +40,7 @@ resource "gpcn_network" "example_standard" {
   dhcp_end_address   = "10.0.0.254"
 
   # DNS servers
-  dns_servers = "8.8.8.8"
+  dns_servers = ["8.8.8.8"]
 }
 
 output "gpcn_network_example_standard" {
@@ -59,3 +59,91 @@ resource "gpcn_network" "example_custom" {
 output "gpcn_network_example_custom" {
   value = gpcn_network.example_custom
 }
+
+# Example 3: Standard Network without outbound access
+resource "gpcn_network" "example_isolated" {
+  name          = "terraform-demo-isolated"
+  network_type  = "standard"
+  datacenter_id = data.gpcn_datacenters.east_us.datacenters[0].id
+
+  description = "Standard network that hands out addresses but has no outbound access"
+
+  cidr_block = "192.168.0.0/24"
+
+  dhcp_start_address = "192.168.0.10"
+  dhcp_end_address   = "192.168.0.254"
+
+  dns_servers = ["192.168.0.2"]
+
+  # Routing and DHCP settings default to enabled for standard networks, and the
+  # default route defaults to the gateway of cidr_block (192.168.0.1)
+  default_route = "192.168.0.2"
+  snat_enabled  = false
+}
+
+output "gpcn_network_example_isolated" {
+  value = gpcn_network.example_isolated
+}
+
+# Example 4: Standard Network with several DHCP ranges
+resource "gpcn_network" "example_pools" {
+  name          = "terraform-demo-pools"
+  network_type  = "standard"
+  datacenter_id = data.gpcn_datacenters.east_us.datacenters[0].id
+
+  cidr_block = "172.16.0.0/24"
+
+  # Replaces dhcp_start_address and dhcp_end_address. Addresses outside the
+  # pools, such as 172.16.0.100 - 172.16.0.149, are left for static assignment
+  allocation_pools = [
+    { start = "172.16.0.10", end = "172.16.0.99" },
+    { start = "172.16.0.150", end = "172.16.0.254" },
+  ]
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+output "gpcn_network_example_pools" {
+  value = gpcn_network.example_pools
+}
+
+# Example 5: Dual-stack Network with IPv4 and IPv6
+resource "gpcn_network" "example_dual_stack" {
+  name          = "terraform-demo-dual-stack"
+  network_type  = "standard"
+  datacenter_id = data.gpcn_datacenters.east_us.datacenters[0].id
+
+  cidr_block = "10.1.0.0/24"
+
+  dhcp_start_address = "10.1.0.10"
+  dhcp_end_address   = "10.1.0.254"
+
+  # ipv6_address_mode defaults to "slaac", which requires a /64 prefix
+  ipv6_cidr_block = "2001:db8:0:1::/64"
+
+  dns_servers = ["8.8.8.8", "2001:4860:4860::8888"]
+}
+
+output "gpcn_network_example_dual_stack" {
+  value = gpcn_network.example_dual_stack
+}
+
+# Example 6: Custom Network routing to a VPN appliance virtual machine
+resource "gpcn_network" "example_routes" {
+  name          = "terraform-demo-routes"
+  network_type  = "custom"
+  datacenter_id = data.gpcn_datacenters.east_us.datacenters[0].id
+
+  cidr_block = "10.2.0.0/24"
+
+  # Traffic for the on-premises networks is sent to the VPN appliance at
+  # 10.2.0.5, which must lie within cidr_block
+  routes = [
+    { destination = "192.168.10.0/24", next_hop = "10.2.0.5" },
+    { destination = "192.168.20.0/24", next_hop = "10.2.0.5" },
+  ]
+}
+
+output "gpcn_network_example_routes" {
+  value = gpcn_network.example_routes
+}
@@ -0,0 +1,7 @@
+import {
+  to       = gpcn_virtualmachine.example
+  identity = {
+    id            = "c13808d9-3b7d-42c5-a21d-f0961308a38a"
+    datacenter_id = "7a3c1e52-9b0d-4f6e-8d21-3c5b7e9f0a14"
+  }
+}
@@ -1 +1,11 @@
+# Import by ID
 terraform import gpcn_virtualmachine.example "c13808d9-3b7d-42c5-a21d-f0961308a38a"
+
+# Import by name, searching every datacenter
+terraform import gpcn_virtualmachine.example "name:terraform-demo-vm"
+
+# Import by datacenter name and name
+terraform import gpcn_virtualmachine.example "<datacenter_name>/terraform-demo-vm"
+
+# The same, with the optional dc: prefix
+terraform import gpcn_virtualmachine.example "dc:<datacenter_name>/terraform-demo-vm"
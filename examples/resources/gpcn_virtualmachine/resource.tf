@@ -40,7 +40,7 @@ resource "gpcn_network" "vm_network" {
   dhcp_end_address   = "10.0.0.254"
 
   # DNS servers
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 
 # Create a custom network for additional connectivity
@@ -71,6 +71,14 @@ resource "gpcn_virtualmachine" "example" {
 
   wait_for_startup = false
 
+  # Fail the plan instead of stopping the virtual machine for a resize or a
+  # detach. Attaching networks and volumes does not need a stop
+  allow_stop_for_update = false
+
+  # Delete the virtual machine when its create fails halfway, instead of
+  # keeping it in the state for the next apply to replace or finish
+  rollback_on_failure = true
+
   # Networking
   allocate_public_ip = false
   network_ids = [
@@ -78,6 +86,10 @@ resource "gpcn_virtualmachine" "example" {
     gpcn_network.vm_network_custom.id
   ]
 
+  # Required with more than one network. Only a standard network can be the
+  # primary when allocate_public_ip is true
+  primary_network_id = gpcn_network.vm_network.id
+
   # Storage
   volume_ids = [
     gpcn_volume.vm_storage.id
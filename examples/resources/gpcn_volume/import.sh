@@ -1 +1,11 @@
+# Import by ID
 terraform import gpcn_volume.example_ssd "c13808d9-3b7d-42c5-a21d-f0961308a38a"
+
+# Import by name, searching every datacenter
+terraform import gpcn_volume.example_ssd "name:terraform-demo-ssd"
+
+# Import by datacenter name and name
+terraform import gpcn_volume.example_ssd "<datacenter_name>/terraform-demo-ssd"
+
+# The same, with the optional dc: prefix
+terraform import gpcn_volume.example_ssd "dc:<datacenter_name>/terraform-demo-ssd"
@@ -1,3 +1,6 @@
 package client
 
 var JOBS_BASE_URL_V1 string = "/v1/resource/jobs/"
+
+// Upper bound on the pages ListAllPages walks through, so a collection endpoint that keeps returning full pages cannot loop forever
+var MAX_LIST_PAGES int = 100
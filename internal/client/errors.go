@@ -4,3 +4,10 @@ package client
 const (
 	ErrLongPollingTimeout = "After 10 minutes, the job status was still not completed."
 )
+
+// Pagination error templates
+const (
+	ErrListPageStatus       = "listing %s failed on page %d with status code %d: %s"
+	ErrListPageUnsuccessful = "listing %s failed on page %d: %s"
+	ErrListTooManyPages     = "listing %s stopped after %d pages of %d items without reaching the last page. Please report this issue to the provider developers"
+)
@@ -7,3 +7,10 @@ const (
 	LogStartingLongPollingIteration         = "Starting long polling iteration %d for %s. Seconds spent: %d"
 	LogLongPollingCompletedSuccessfully     = "Long polling completed successfully for action: %s"
 )
+
+// Pagination messages
+const (
+	LogStartingListAllPages       = "Starting ListAllPages for %s"
+	LogRetrievedListPage          = "Retrieved page %d of %s with %d items"
+	LogSuccessfullyListedAllPages = "Successfully listed %d items of %s"
+)
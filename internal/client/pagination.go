@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// A single page of a collection endpoint
+type listPageResponse[T any] struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	Data    []T    `json:"data"`
+}
+
+// ListAllPages walks through every page of a collection endpoint and returns the items of all pages. The URL may already carry
+// a query string. A status code outside of 2xx or a response with success set to false fails the whole listing, so an API error
+// is never mistaken for an empty collection. The action names the collection in logs and errors, e.g. "GPCN Networks"
+func ListAllPages[T any](httpClient *http.Client, ctx context.Context, action, url string, pageSize int) ([]T, error) {
+	tflog.Info(ctx, fmt.Sprintf(LogStartingListAllPages, action))
+	separator := "?"
+	if strings.Contains(url, "?") {
+		separator = "&"
+	}
+
+	var items []T
+	for page := 1; page <= MAX_LIST_PAGES; page++ {
+		request, err := http.NewRequest("GET", url+separator+"page="+strconv.Itoa(page)+"&limit="+strconv.Itoa(pageSize), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := httpClient.Do(request)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := io.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if response.StatusCode < 200 || response.StatusCode > 299 {
+			return nil, fmt.Errorf(ErrListPageStatus, action, page, response.StatusCode, string(body))
+		}
+
+		var pageResponse listPageResponse[T]
+		err = json.Unmarshal(body, &pageResponse)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !pageResponse.Success {
+			return nil, fmt.Errorf(ErrListPageUnsuccessful, action, page, pageResponse.Message)
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf(LogRetrievedListPage, page, action, len(pageResponse.Data)))
+		items = append(items, pageResponse.Data...)
+		// A short page means there is nothing left to fetch
+		if len(pageResponse.Data) < pageSize {
+			tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyListedAllPages, len(items), action))
+			return items, nil
+		}
+	}
+
+	return nil, fmt.Errorf(ErrListTooManyPages, action, MAX_LIST_PAGES, pageSize)
+}
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type testItem struct {
+	ID int `json:"id"`
+}
+
+// Serves a collection of the given size, in pages of the requested limit
+func newCollectionServer(total int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		var ids []string
+		for id := (page - 1) * limit; id < min(page*limit, total); id++ {
+			ids = append(ids, fmt.Sprintf(`{"id":%d}`, id))
+		}
+		fmt.Fprintf(w, `{"success":true,"message":"","data":[%s]}`, strings.Join(ids, ","))
+	}))
+}
+
+func TestListAllPages(t *testing.T) {
+	for _, total := range []int{0, 3, 10, 11} {
+		server := newCollectionServer(total)
+		httpClient, _ := NewHttpClient(server.URL, "key")
+
+		items, err := ListAllPages[testItem](httpClient, context.Background(), "items", "/items", 5)
+		server.Close()
+		if err != nil {
+			t.Fatalf("listing %d items: unexpected error: %s", total, err)
+		}
+		if len(items) != total {
+			t.Fatalf("listing %d items: got %d", total, len(items))
+		}
+		for i, item := range items {
+			if item.ID != i {
+				t.Fatalf("listing %d items: item %d has ID %d", total, i, item.ID)
+			}
+		}
+	}
+}
+
+func TestListAllPagesKeepsQueryString(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("countryName") != "Canada" || r.URL.Query().Get("page") != "1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, `{"success":true,"data":[{"id":1}]}`)
+	}))
+	defer server.Close()
+	httpClient, _ := NewHttpClient(server.URL, "key")
+
+	items, err := ListAllPages[testItem](httpClient, context.Background(), "items", "/items?countryName=Canada", 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("got %d items, expected 1", len(items))
+	}
+}
+
+func TestListAllPagesErrors(t *testing.T) {
+	testCases := map[string]struct {
+		handler       http.HandlerFunc
+		expectedError string
+	}{
+		"status code": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"success":false,"message":"bad filter"}`)
+			},
+			expectedError: "status code 400",
+		},
+		"transport error": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+			},
+			expectedError: "Status code: 401",
+		},
+		"unsuccessful response": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"success":false,"message":"invalid api key","data":[]}`)
+			},
+			expectedError: "invalid api key",
+		},
+		"unsuccessful later page": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("page") == "2" {
+					fmt.Fprint(w, `{"success":false,"message":"internal error"}`)
+					return
+				}
+				fmt.Fprint(w, `{"success":true,"data":[{"id":1},{"id":2}]}`)
+			},
+			expectedError: "failed on page 2: internal error",
+		},
+		"endless pages": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"success":true,"data":[{"id":1},{"id":2}]}`)
+			},
+			expectedError: "stopped after 100 pages",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(testCase.handler)
+			defer server.Close()
+			httpClient, _ := NewHttpClient(server.URL, "key")
+
+			items, err := ListAllPages[testItem](httpClient, context.Background(), "items", "/items", 2)
+			if err == nil {
+				t.Fatalf("expected an error, got %d items", len(items))
+			}
+			if !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Fatalf("expected error containing %q, got: %s", testCase.expectedError, err)
+			}
+		})
+	}
+}
@@ -1,3 +1,6 @@
 package datacenters
 
 var BASE_URL_V1 string = "/v1/resource/data-centers/"
+
+// Page size used when walking through the datacenter collection endpoints
+var LIST_PAGE_SIZE int = 100
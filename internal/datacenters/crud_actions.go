@@ -0,0 +1,133 @@
+package datacenters
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-gpcn/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type datacenterDataResponse struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	RegionID            int64  `json:"regionId"`
+	RegionName          string `json:"regionName"`
+	CountryID           int64  `json:"countryId"`
+	CountryName         string `json:"countryName"`
+	CountryAbbreviation string `json:"countryAbbreviation"`
+}
+
+type datacenterRegionDataResponse struct {
+	ID                  int64  `json:"id"`
+	Name                string `json:"name"`
+	CountryID           int64  `json:"countryId"`
+	CountryName         string `json:"countryName"`
+	CountryAbbreviation string `json:"countryAbbreviation"`
+}
+
+type datacenterCountryDataResponse struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// Get the datacenters matching the given query string filters (e.g. "&countryName=Canada"), walking through every page
+func GetDatacenters(httpClient *http.Client, ctx context.Context, queryString string) ([]datacenterDataResponse, error) {
+	tflog.Info(ctx, LogStartingGetDatacenters)
+	datacenterUrl := BASE_URL_V1
+	if queryString != "" {
+		datacenterUrl += "?" + strings.TrimPrefix(queryString, "&")
+	}
+	datacenters, err := client.ListAllPages[datacenterDataResponse](httpClient, ctx, "GPCN Datacenters", datacenterUrl, LIST_PAGE_SIZE)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyRetrievedDatacenters, len(datacenters)))
+	return datacenters, nil
+}
+
+// Find the single datacenter with the given ID
+func GetDatacenterById(httpClient *http.Client, ctx context.Context, datacenterId string) (*datacenterDataResponse, error) {
+	tflog.Info(ctx, fmt.Sprintf(LogStartingGetDatacenterWithID, datacenterId))
+	allDatacenters, err := GetDatacenters(httpClient, ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, datacenter := range allDatacenters {
+		if datacenter.ID == datacenterId {
+			tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyRetrievedDatacenterWithID, datacenterId))
+			return &datacenter, nil
+		}
+	}
+
+	return nil, fmt.Errorf(ErrDetailDatacenterIDNotFound, datacenterId)
+}
+
+// Find the single datacenter with the given name. Names are compared case-insensitively. Errors if none or several match
+func GetDatacenterByName(httpClient *http.Client, ctx context.Context, name string) (*datacenterDataResponse, error) {
+	tflog.Info(ctx, fmt.Sprintf(LogStartingGetDatacenterByName, name))
+	allDatacenters, err := GetDatacenters(httpClient, ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []datacenterDataResponse
+	var names []string
+	for _, datacenter := range allDatacenters {
+		names = append(names, datacenter.Name)
+		if strings.EqualFold(datacenter.Name, name) {
+			matches = append(matches, datacenter)
+		}
+	}
+
+	if len(matches) < 1 {
+		return nil, fmt.Errorf(ErrDetailDatacenterNameNotFound, name, strings.Join(names, ", "))
+	}
+	if len(matches) > 1 {
+		var matchingIds []string
+		for _, datacenter := range matches {
+			matchingIds = append(matchingIds, datacenter.ID)
+		}
+		return nil, fmt.Errorf(ErrDetailDatacenterNameAmbiguous, len(matches), name, strings.Join(matchingIds, ", "))
+	}
+
+	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyRetrievedDatacenterByName, name))
+	return &matches[0], nil
+}
+
+// Find a datacenter by its ID when one is given, otherwise by its name
+func FindDatacenter(httpClient *http.Client, ctx context.Context, datacenterId, name string) (*datacenterDataResponse, error) {
+	if datacenterId != "" {
+		return GetDatacenterById(httpClient, ctx, datacenterId)
+	}
+	return GetDatacenterByName(httpClient, ctx, name)
+}
+
+// Get the regions that have datacenters, optionally filtered by an already escaped country name
+func GetCountriesAndRegions(httpClient *http.Client, ctx context.Context, countryName string) ([]datacenterRegionDataResponse, error) {
+	tflog.Info(ctx, LogStartingGetCountriesAndRegions)
+	// Safe to use since it'll default to empty string if not provided, which will just search all countries
+	regions, err := client.ListAllPages[datacenterRegionDataResponse](httpClient, ctx, "GPCN Regions", BASE_URL_V1+"regions?countryName="+countryName, LIST_PAGE_SIZE)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyRetrievedCountriesAndRegions, len(regions)))
+	return regions, nil
+}
+
+// Get every country that has datacenters
+func GetAllCountries(httpClient *http.Client, ctx context.Context) ([]datacenterCountryDataResponse, error) {
+	tflog.Info(ctx, LogStartingGetAllCountries)
+	countries, err := client.ListAllPages[datacenterCountryDataResponse](httpClient, ctx, "GPCN Countries", BASE_URL_V1+"countries", LIST_PAGE_SIZE)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyRetrievedAllCountries, len(countries)))
+	return countries, nil
+}
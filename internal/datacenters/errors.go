@@ -2,11 +2,20 @@ package datacenters
 
 // Error summary constants
 const (
-	ErrSummaryUnableGetDatacenters = "Unable to get GPCN Datacenters"
+	ErrSummaryUnableGetDatacenters            = "Unable to get GPCN Datacenters"
+	ErrSummaryUnableGetDatacenter             = "Unable to get GPCN Datacenter"
+	ErrSummaryUnableGetDatacenterCapabilities = "Unable to get GPCN Datacenter capabilities"
+	ErrSummaryUnableGetRegions                = "Unable to get GPCN Regions"
+	ErrSummaryUnableGetCountries              = "Unable to get GPCN Countries"
 )
 
 // Error detail message templates
 const (
-	ErrDetailDatacenterNotFound          = "A datacenter with the provided information was not found. Some possible values are: %s"
-	ErrDetailDatacenterNotFoundCountries = "A datacenter with the provided information was not found. Possible country values are: %s"
+	ErrDetailDatacenterNotFound              = "A datacenter with the provided information was not found. Some possible values are: %s"
+	ErrDetailDatacenterNotFoundCountries     = "A datacenter with the provided information was not found. Possible country values are: %s"
+	ErrDetailDatacenterIDNotFound            = "No datacenter with ID: '%s' was found"
+	ErrDetailDatacenterNameNotFound          = "No datacenter named '%s' was found. Possible values are: %s"
+	ErrDetailDatacenterNameAmbiguous         = "%d datacenters named '%s' were found. Look the datacenter up by 'id' instead. Matching IDs are: %s"
+	ErrDetailUnableToListImagesForDatacenter = "Unable to list images for the datacenter with ID: '%s'"
+	ErrDetailUnableToListSizesForImage       = "Unable to list sizes for image '%s' in the datacenter with ID: '%s'"
 )
@@ -0,0 +1,33 @@
+package datacenters
+
+// Log message constants for datacenter operations
+const (
+	// GetDatacenters messages
+	LogStartingGetDatacenters           = "Starting GetDatacenters"
+	LogSuccessfullyRetrievedDatacenters = "Successfully retrieved %d datacenters"
+
+	// GetDatacenterById messages
+	LogStartingGetDatacenterWithID           = "Starting GetDatacenterById for datacenter ID: %s"
+	LogSuccessfullyRetrievedDatacenterWithID = "Successfully retrieved datacenter with ID: %s"
+
+	// GetDatacenterByName messages
+	LogStartingGetDatacenterByName           = "Starting GetDatacenterByName for datacenter name: %s"
+	LogSuccessfullyRetrievedDatacenterByName = "Successfully retrieved datacenter with name: %s"
+
+	// GetCountriesAndRegions messages
+	LogStartingGetCountriesAndRegions           = "Starting GetCountriesAndRegions"
+	LogSuccessfullyRetrievedCountriesAndRegions = "Successfully retrieved %d regions"
+
+	// GetAllCountries messages
+	LogStartingGetAllCountries           = "Starting GetAllCountries"
+	LogSuccessfullyRetrievedAllCountries = "Successfully retrieved %d countries"
+
+	// Data source operation messages
+	LogStartingReadGPCNDatacenterDataSource             = "Starting Read GPCN Datacenter data source"
+	LogSuccessfullyFinishedReadGPCNDatacenterDataSource = "Successfully finished Read GPCN Datacenter data source"
+	LogCollectingDatacenterCapabilities                 = "Collecting capabilities for datacenter ID: %s"
+	LogStartingReadGPCNRegionsDataSource                = "Starting Read GPCN Regions data source"
+	LogSuccessfullyFinishedReadGPCNRegionsDataSource    = "Successfully finished Read GPCN Regions data source"
+	LogStartingReadGPCNCountriesDataSource              = "Starting Read GPCN Countries data source"
+	LogSuccessfullyFinishedReadGPCNCountriesDataSource  = "Successfully finished Read GPCN Countries data source"
+)
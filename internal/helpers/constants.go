@@ -0,0 +1,9 @@
+package helpers
+
+// Import identifier formats for looking a resource up by name
+var IMPORT_NAME_PREFIX = "name:"
+var IMPORT_DATACENTER_PREFIX = "dc:"
+var IMPORT_DATACENTER_SEPARATOR = "/"
+
+// Number of attach and detach jobs that run at the same time during one update
+var MAX_PARALLEL_ATTACHMENT_JOBS = 3
@@ -0,0 +1,6 @@
+package helpers
+
+// Import identifier error templates
+const (
+	ErrInvalidDatacenterImportID = "the import ID '%s' must have the form '<datacenter_name>/<name>'"
+)
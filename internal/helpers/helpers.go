@@ -1,6 +1,11 @@
 package helpers
 
-import "slices"
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
 
 // Helper function to check a list for differences and return added and removed values
 func CheckListForDifferences(oldList, newList []string) ([]string, []string) {
@@ -23,6 +28,23 @@ func CheckListForDifferences(oldList, newList []string) ([]string, []string) {
 	return addedValues, removedValues
 }
 
+// Helper function to order values like a reference list. Values found in the reference keep its order, and values that are not
+// in it follow in their original order. Used to keep lists read back from the API in the order of the configuration
+func OrderLike(values, reference []string) []string {
+	ordered := []string{}
+	for _, elem := range reference {
+		if slices.Contains(values, elem) && !slices.Contains(ordered, elem) {
+			ordered = append(ordered, elem)
+		}
+	}
+	for _, elem := range values {
+		if !slices.Contains(ordered, elem) {
+			ordered = append(ordered, elem)
+		}
+	}
+	return ordered
+}
+
 // Helper function to join strings with comma separator
 func JoinStrings(strs []string) string {
 	result := ""
@@ -34,3 +56,48 @@ func JoinStrings(strs []string) string {
 	}
 	return result
 }
+
+// Helper function to split an import identifier of the form "name:<name>" or "<datacenter_name>/<name>" into its datacenter name
+// and resource name. The datacenter name is empty for the "name:" form, and "dc:<datacenter_name>/<name>" is accepted as an alias
+// of the datacenter-qualified form. Since IDs are UUIDs, which never contain the separator, an identifier without either form is a
+// plain ID, for which false is returned. Returns an error when the datacenter-qualified form is missing either of its parts
+func ParseNameImportID(importId string) (string, string, bool, error) {
+	if name, found := strings.CutPrefix(importId, IMPORT_NAME_PREFIX); found {
+		return "", name, true, nil
+	}
+	qualifiedName, hasPrefix := strings.CutPrefix(importId, IMPORT_DATACENTER_PREFIX)
+	datacenterName, name, found := strings.Cut(qualifiedName, IMPORT_DATACENTER_SEPARATOR)
+	if !found && !hasPrefix {
+		return "", "", false, nil
+	}
+	if datacenterName == "" || name == "" {
+		return "", "", false, fmt.Errorf(ErrInvalidDatacenterImportID, importId)
+	}
+	return datacenterName, name, true, nil
+}
+
+// Helper function to run an action for every ID with at most limit actions running at the same time. Every ID is attempted even
+// when others fail, and the errors are returned keyed by ID. IDs that succeeded are not in the map
+func RunForEachID(ids []string, limit int, action func(id string) error) map[string]error {
+	failures := map[string]error{}
+	var mutex sync.Mutex
+	var waitGroup sync.WaitGroup
+	slots := make(chan struct{}, max(limit, 1))
+	for _, id := range ids {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			err := action(id)
+			if err != nil {
+				mutex.Lock()
+				failures[id] = err
+				mutex.Unlock()
+			}
+		}()
+	}
+	waitGroup.Wait()
+	return failures
+}
@@ -0,0 +1,134 @@
+package helpers
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParseNameImportID(t *testing.T) {
+	testCases := map[string]struct {
+		importId       string
+		datacenterName string
+		name           string
+		isName         bool
+		expectError    bool
+	}{
+		"plain ID": {
+			importId: "c13808d9-3b7d-42c5-a21d-f0961308a38a",
+		},
+		"name": {
+			importId: "name:web",
+			name:     "web",
+			isName:   true,
+		},
+		"name containing a separator": {
+			importId: "name:team/web",
+			name:     "team/web",
+			isName:   true,
+		},
+		"datacenter and name": {
+			importId:       "Toronto 1/web",
+			datacenterName: "Toronto 1",
+			name:           "web",
+			isName:         true,
+		},
+		"datacenter and name with the dc: alias": {
+			importId:       "dc:Toronto 1/web",
+			datacenterName: "Toronto 1",
+			name:           "web",
+			isName:         true,
+		},
+		"datacenter and name containing a separator": {
+			importId:       "Toronto 1/team/web",
+			datacenterName: "Toronto 1",
+			name:           "team/web",
+			isName:         true,
+		},
+		"datacenter without name": {
+			importId:    "dc:Toronto 1",
+			expectError: true,
+		},
+		"empty datacenter": {
+			importId:    "/web",
+			expectError: true,
+		},
+		"empty name": {
+			importId:    "Toronto 1/",
+			expectError: true,
+		},
+		"empty datacenter with the dc: alias": {
+			importId:    "dc:/web",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			datacenterName, resourceName, isName, err := ParseNameImportID(testCase.importId)
+			if testCase.expectError {
+				if err == nil {
+					t.Fatalf("expected an error for %q", testCase.importId)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if datacenterName != testCase.datacenterName || resourceName != testCase.name || isName != testCase.isName {
+				t.Fatalf("got (%q, %q, %t), expected (%q, %q, %t)", datacenterName, resourceName, isName, testCase.datacenterName, testCase.name, testCase.isName)
+			}
+		})
+	}
+}
+
+func TestRunForEachID(t *testing.T) {
+	ids := []string{"a", "b", "c", "d", "e", "f", "g"}
+	for _, limit := range []int{0, 1, 3, 10} {
+		var mutex sync.Mutex
+		running, maxRunning := 0, 0
+		attempted := map[string]bool{}
+
+		failures := RunForEachID(ids, limit, func(id string) error {
+			mutex.Lock()
+			running++
+			maxRunning = max(maxRunning, running)
+			attempted[id] = true
+			mutex.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mutex.Lock()
+			running--
+			mutex.Unlock()
+			if id == "b" || id == "f" {
+				return fmt.Errorf("failed %s", id)
+			}
+			return nil
+		})
+
+		if expected := min(max(limit, 1), len(ids)); maxRunning != expected {
+			t.Fatalf("limit %d: %d actions ran at the same time, expected %d", limit, maxRunning, expected)
+		}
+		if len(attempted) != len(ids) {
+			t.Fatalf("limit %d: attempted %d IDs, expected every one of %d even after failures", limit, len(attempted), len(ids))
+		}
+		if len(failures) != 2 || failures["b"] == nil || failures["f"] == nil {
+			t.Fatalf("limit %d: expected failures for 'b' and 'f' only, got: %v", limit, failures)
+		}
+		if failures["b"].Error() != "failed b" {
+			t.Fatalf("limit %d: the failure of 'b' is reported as %q", limit, failures["b"])
+		}
+	}
+}
+
+func TestRunForEachIDWithoutIDs(t *testing.T) {
+	failures := RunForEachID(nil, 3, func(id string) error {
+		t.Fatalf("unexpected action for %q", id)
+		return nil
+	})
+	if len(failures) != 0 {
+		t.Fatalf("expected no failures, got: %v", failures)
+	}
+}
@@ -0,0 +1,43 @@
+package networks
+
+import (
+	"errors"
+	"net"
+)
+
+// Derive the default gateway of a CIDR block, which is the first address after the network address (e.g. 10.0.0.1 for 10.0.0.0/24)
+func DefaultGatewayForCIDR(cidrBlock string) (string, error) {
+	parsedIpNet, networkAddress, _, err := CIDRBounds(cidrBlock)
+	if err != nil {
+		return "", err
+	}
+
+	gateway := make(net.IP, len(networkAddress))
+	copy(gateway, networkAddress)
+	gateway[len(gateway)-1]++
+	if !parsedIpNet.Contains(gateway) {
+		return "", errors.New("the CIDR block '" + cidrBlock + "' is too small to contain a gateway")
+	}
+
+	return gateway.String(), nil
+}
+
+// Parse an IPv4 CIDR block and return its network and broadcast addresses
+func CIDRBounds(cidrBlock string) (*net.IPNet, net.IP, net.IP, error) {
+	_, parsedIpNet, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	networkAddress := parsedIpNet.IP.To4()
+	if networkAddress == nil {
+		return nil, nil, nil, errors.New("the CIDR block '" + cidrBlock + "' is not an IPv4 CIDR block")
+	}
+
+	broadcastAddress := make(net.IP, len(networkAddress))
+	for i := range networkAddress {
+		broadcastAddress[i] = networkAddress[i] | ^parsedIpNet.Mask[i]
+	}
+
+	return parsedIpNet, networkAddress, broadcastAddress, nil
+}
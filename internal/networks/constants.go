@@ -2,10 +2,27 @@ package networks
 
 var VIRTUAL_MACHINES_BASE_URL_V1 string = "/v1/resource/virtual-machines/"
 var BASE_URL_V1 string = "/v1/resource/networks/"
+var LIST_PAGE_SIZE int = 100
 
 // Network types
 var NETWORK_TYPE_CUSTOM = "custom"
 var NETWORK_TYPE_STANDARD = "standard"
 
+// Prefix lengths accepted for cidr_block. Smaller blocks leave no room for a DHCP range next to the reserved addresses
+var MIN_CIDR_PREFIX_LENGTH = 16
+var MAX_CIDR_PREFIX_LENGTH = 29
+
+// IPv6 address assignment modes. SLAAC lets hosts configure themselves from router advertisements and requires a /64 prefix
+var IPV6_ADDRESS_MODE_SLAAC = "slaac"
+var IPV6_ADDRESS_MODE_DHCPV6_STATEFUL = "dhcpv6-stateful"
+var IPV6_ADDRESS_MODE_DHCPV6_STATELESS = "dhcpv6-stateless"
+
+// Prefix lengths accepted for ipv6_cidr_block
+var MIN_IPV6_CIDR_PREFIX_LENGTH = 48
+var MAX_IPV6_CIDR_PREFIX_LENGTH = 64
+
+// Default route sent for every standard network by schema version 0, regardless of its CIDR block
+var LEGACY_DEFAULT_ROUTE = "10.0.0.1"
+
 // Delete Network constants
 var DELETE_NETWORK_RETRY_COUNT = 5
@@ -8,10 +8,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"terraform-provider-gpcn/internal/client"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -21,21 +21,30 @@ type readNetworkResponse struct {
 	Data    readNetworkDataResponse `json:"data"`
 }
 type readNetworkDataResponse struct {
-	ID              string                                    `json:"id"`
-	Name            string                                    `json:"name"`
-	Description     string                                    `json:"description"`
-	CreatedAt       string                                    `json:"createdAt"`
-	UpdatedAt       string                                    `json:"updatedAt"`
-	SNAT            string                                    `json:"snat"`
-	CIDRBlock       string                                    `json:"cidrBlock"`
-	Gateway         string                                    `json:"gatewayIp"`
-	ConnectedVMs    string                                    `json:"connectedVms"`
-	NetworkType     string                                    `json:"networkType"`
-	Country         readNetworkDataLocationResponse           `json:"country"`
-	Region          readNetworkDataLocationResponse           `json:"region"`
-	Datacenter      readNetworkDataLocationDatacenterResponse `json:"datacenter"`
-	DNSServers      string                                    `json:"dnsNameservers"`
-	AllocationPools []readNetworkDataAllocationPoolResponse   `json:"allocationPools"`
+	ID                     string                                    `json:"id"`
+	Name                   string                                    `json:"name"`
+	Description            string                                    `json:"description"`
+	CreatedAt              string                                    `json:"createdAt"`
+	UpdatedAt              string                                    `json:"updatedAt"`
+	SNAT                   string                                    `json:"snat"`
+	CIDRBlock              string                                    `json:"cidrBlock"`
+	Gateway                string                                    `json:"gatewayIp"`
+	ConnectedVMs           string                                    `json:"connectedVms"`
+	NetworkType            string                                    `json:"networkType"`
+	Country                readNetworkDataLocationResponse           `json:"country"`
+	Region                 readNetworkDataLocationResponse           `json:"region"`
+	Datacenter             readNetworkDataLocationDatacenterResponse `json:"datacenter"`
+	DNSServers             string                                    `json:"dnsNameservers"`
+	AllocationPools        []readNetworkDataAllocationPoolResponse   `json:"allocationPools"`
+	DefaultRoute           *string                                   `json:"defaultRoute"`
+	DefaultRouteEnabled    *bool                                     `json:"defaultRouteEnabled"`
+	DHCPServerEnabled      *bool                                     `json:"dhcpServerEnabled"`
+	ServeDNSServersEnabled *bool                                     `json:"serveDNSServersEnabled"`
+	SNATEnabled            *bool                                     `json:"snatEnabled"`
+	IPv6CIDRBlock          *string                                   `json:"ipv6CidrBlock"`
+	IPv6Gateway            *string                                   `json:"ipv6GatewayIp"`
+	IPv6AddressMode        *string                                   `json:"ipv6AddressMode"`
+	Routes                 []readNetworkDataRouteResponse            `json:"routes"`
 }
 type readNetworkDataLocationResponse struct {
 	ID   int64  `json:"id"`
@@ -49,32 +58,45 @@ type readNetworkDataAllocationPoolResponse struct {
 	Start string `json:"start"`
 	End   string `json:"end"`
 }
+type readNetworkDataRouteResponse struct {
+	Destination string `json:"destination"`
+	NextHop     string `json:"nextHop"`
+}
+
+// Returns the start or end address of the first allocation pool, or an empty string when there are no pools
+func firstPoolAddress(pools []readNetworkDataAllocationPoolResponse, start bool) string {
+	if len(pools) == 0 {
+		return ""
+	}
+	if start {
+		return pools[0].Start
+	}
+	return pools[0].End
+}
 
 func CreateNetwork(httpClient *http.Client, ctx context.Context, model ResourceModel) (*readNetworkResponse, error) {
 	tflog.Info(ctx, LogStartingCreateNetwork)
-	isStandardNetwork := model.NetworkType == types.StringValue("standard")
-	var defaultRoute string
-	if isStandardNetwork {
-		defaultRoute = "10.0.0.1"
-	} else {
-		defaultRoute = ""
-	}
 
-	// Create a new request from the model
+	// Create a new request from the model. The DHCP start and end addresses still describe the first pool for older API versions
+	allocationPools := AllocationPoolsFromModel(ctx, model)
 	createNetworkRequestBody := map[string]any{
+		"allocationPools":        allocationPools,
 		"cidrBlock":              model.CIDRBlock.ValueString(),
-		"defaultRoute":           defaultRoute,
-		"defaultRouteEnabled":    isStandardNetwork,
+		"defaultRoute":           model.DefaultRoute.ValueString(),
+		"defaultRouteEnabled":    model.DefaultRouteEnabled.ValueBool(),
 		"datacenterId":           model.DatacenterId.ValueString(),
 		"description":            model.Description.ValueString(),
-		"dhcpStartAddress":       model.DHCPStartAddress.ValueString(),
-		"dhcpEndAddress":         model.DHCPEndAddress.ValueString(),
-		"dhcpServerEnabled":      isStandardNetwork,
-		"dnsServers":             model.DNSServers.ValueString(),
+		"dhcpStartAddress":       firstPoolAddress(allocationPools, true),
+		"dhcpEndAddress":         firstPoolAddress(allocationPools, false),
+		"dhcpServerEnabled":      model.DHCPEnabled.ValueBool(),
+		"dnsServers":             FormatDNSServers(ctx, model.DNSServers),
+		"ipv6AddressMode":        model.IPv6AddressMode.ValueString(),
+		"ipv6CidrBlock":          model.IPv6CIDRBlock.ValueString(),
 		"name":                   model.Name.ValueString(),
 		"networkType":            model.NetworkType.ValueString(),
-		"serveDNSServersEnabled": isStandardNetwork,
-		"snatEnabled":            isStandardNetwork,
+		"routes":                 RoutesFromModel(ctx, model),
+		"serveDNSServersEnabled": model.ServeDNSEnabled.ValueBool(),
+		"snatEnabled":            model.SNATEnabled.ValueBool(),
 	}
 
 	jsonCreateNetworkRequestBody, err := json.Marshal(createNetworkRequestBody)
@@ -156,29 +178,71 @@ func GetNetwork(client *http.Client, ctx context.Context, networkId string) (*re
 	return &readNetworkResponse, nil
 }
 
+// Lists every network visible to the API key, walking through each page of the collection endpoint
+func ListNetworks(httpClient *http.Client, ctx context.Context) ([]readNetworkDataResponse, error) {
+	tflog.Info(ctx, LogStartingListNetworks)
+	networks, err := client.ListAllPages[readNetworkDataResponse](httpClient, ctx, "GPCN Networks", BASE_URL_V1, LIST_PAGE_SIZE)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyListedNetworks, len(networks)))
+	return networks, nil
+}
+
+// Finds the single network with the given name, optionally scoped to a datacenter. Errors if none or several match
+func GetNetworkByName(httpClient *http.Client, ctx context.Context, datacenterId, name string) (*readNetworkDataResponse, error) {
+	tflog.Info(ctx, fmt.Sprintf(LogStartingGetNetworkByName, name))
+	allNetworks, err := ListNetworks(httpClient, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []readNetworkDataResponse
+	var matchingIds []string
+	for _, network := range allNetworks {
+		if network.Name != name {
+			continue
+		}
+		if datacenterId != "" && network.Datacenter.ID != datacenterId {
+			continue
+		}
+		matches = append(matches, network)
+		matchingIds = append(matchingIds, network.ID)
+	}
+
+	if len(matches) < 1 {
+		return nil, fmt.Errorf(ErrDetailNetworkNameNotFound, name, datacenterId)
+	}
+	if len(matches) > 1 {
+		return nil, fmt.Errorf(ErrDetailNetworkNameAmbiguous, len(matches), name, datacenterId, strings.Join(matchingIds, ", "))
+	}
+
+	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyRetrievedNetworkByName, name))
+	return &matches[0], nil
+}
+
 func UpdateNetwork(httpClient *http.Client, ctx context.Context, networkId string, model ResourceModel) (*readNetworkResponse, error) {
 	tflog.Info(ctx, fmt.Sprintf(LogStartingUpdateNetworkWithID, networkId))
-	isStandardNetwork := model.NetworkType == types.StringValue("standard")
-	var defaultRoute string
-	if isStandardNetwork {
-		defaultRoute = "10.0.0.1"
-	} else {
-		defaultRoute = ""
-	}
 
-	// Create a new request from the model
+	// Create a new request from the model. The DHCP start and end addresses still describe the first pool for older API versions
+	allocationPools := AllocationPoolsFromModel(ctx, model)
 	updateNetworkRequestBody := map[string]any{
+		"allocationPools":        allocationPools,
 		"cidrBlock":              model.CIDRBlock.ValueString(),
-		"defaultRoute":           defaultRoute,
-		"defaultRouteEnabled":    isStandardNetwork,
+		"defaultRoute":           model.DefaultRoute.ValueString(),
+		"defaultRouteEnabled":    model.DefaultRouteEnabled.ValueBool(),
 		"description":            model.Description.ValueString(),
-		"dhcpStartAddress":       model.DHCPStartAddress.ValueString(),
-		"dhcpEndAddress":         model.DHCPEndAddress.ValueString(),
-		"dhcpServerEnabled":      isStandardNetwork,
-		"dnsServers":             model.DNSServers.ValueString(),
+		"dhcpStartAddress":       firstPoolAddress(allocationPools, true),
+		"dhcpEndAddress":         firstPoolAddress(allocationPools, false),
+		"dhcpServerEnabled":      model.DHCPEnabled.ValueBool(),
+		"dnsServers":             FormatDNSServers(ctx, model.DNSServers),
+		"ipv6AddressMode":        model.IPv6AddressMode.ValueString(),
+		"ipv6CidrBlock":          model.IPv6CIDRBlock.ValueString(),
 		"name":                   model.Name.ValueString(),
-		"serveDNSServersEnabled": isStandardNetwork,
-		"snatEnabled":            isStandardNetwork,
+		"routes":                 RoutesFromModel(ctx, model),
+		"serveDNSServersEnabled": model.ServeDNSEnabled.ValueBool(),
+		"snatEnabled":            model.SNATEnabled.ValueBool(),
 	}
 
 	jsonUpdateNetworkRequestBody, err := json.Marshal(updateNetworkRequestBody)
@@ -217,19 +281,7 @@ func DeleteNetwork(httpClient *http.Client, ctx context.Context, networkId strin
 	}
 	tflog.Info(ctx, LogConstructedDeleteNetworkRequest)
 
-	// Detach this network from any virtual machines it may be attached to
-	readNetworksToVMsResponse, err := GetVirtualMachinesAttachedToNetworks(httpClient, ctx, networkId)
-	if err != nil {
-		return err
-	}
-
-	for _, virtualmachine := range readNetworksToVMsResponse.Data {
-		err := RemoveNetworkInterfaceByNetworkId(httpClient, ctx, virtualmachine.ID, networkId)
-		if err != nil {
-			return err
-		}
-	}
-
+	// The network must already be detached from every virtual machine, see detach_on_destroy on the resource
 	// It's possible for the delete job to fail if we are deleting it and a virtual machine at the same time
 	// If this happens, catch the error and don't process it until we've failed sufficiently enough
 	errorCount := 1
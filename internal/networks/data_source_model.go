@@ -0,0 +1,108 @@
+package networks
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type NetworkDataResponseTF struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+	SNAT            types.String `tfsdk:"snat"`
+	CIDRBlock       types.String `tfsdk:"cidr_block"`
+	Gateway         types.String `tfsdk:"gateway"`
+	ConnectedVMs    types.String `tfsdk:"connected_vms"`
+	NetworkType     types.String `tfsdk:"network_type"`
+	DatacenterId    types.String `tfsdk:"datacenter_id"`
+	Datacenter      types.String `tfsdk:"datacenter"`
+	Region          types.String `tfsdk:"region"`
+	Country         types.String `tfsdk:"country"`
+	DNSServers      types.List   `tfsdk:"dns_servers"`
+	AllocationPools types.List   `tfsdk:"allocation_pools"`
+	IPv6CIDRBlock   types.String `tfsdk:"ipv6_cidr_block"`
+	IPv6Gateway     types.String `tfsdk:"ipv6_gateway"`
+	IPv6AddressMode types.String `tfsdk:"ipv6_address_mode"`
+	Routes          types.Set    `tfsdk:"routes"`
+}
+
+type AllocationPoolTF struct {
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+}
+
+func (o AllocationPoolTF) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"start": types.StringType,
+		"end":   types.StringType,
+	}
+}
+
+type RouteTF struct {
+	Destination types.String `tfsdk:"destination"`
+	NextHop     types.String `tfsdk:"next_hop"`
+}
+
+func (o RouteTF) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"destination": types.StringType,
+		"next_hop":    types.StringType,
+	}
+}
+
+func (o NetworkDataResponseTF) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                types.StringType,
+		"name":              types.StringType,
+		"description":       types.StringType,
+		"created_at":        types.StringType,
+		"updated_at":        types.StringType,
+		"snat":              types.StringType,
+		"cidr_block":        types.StringType,
+		"gateway":           types.StringType,
+		"connected_vms":     types.StringType,
+		"network_type":      types.StringType,
+		"datacenter_id":     types.StringType,
+		"datacenter":        types.StringType,
+		"region":            types.StringType,
+		"country":           types.StringType,
+		"dns_servers":       types.ListType{ElemType: types.StringType},
+		"allocation_pools":  types.ListType{ElemType: types.ObjectType{AttrTypes: AllocationPoolTF{}.AttrTypes()}},
+		"ipv6_cidr_block":   types.StringType,
+		"ipv6_gateway":      types.StringType,
+		"ipv6_address_mode": types.StringType,
+		"routes":            types.SetType{ElemType: types.ObjectType{AttrTypes: RouteTF{}.AttrTypes()}},
+	}
+}
+
+// Convert a single entry of the list or GET response into its data source representation
+func MapNetworkDataToTF(ctx context.Context, data readNetworkDataResponse) NetworkDataResponseTF {
+	dnsServersList, _ := types.ListValueFrom(ctx, types.StringType, ParseDNSServers(data.DNSServers))
+
+	return NetworkDataResponseTF{
+		ID:              types.StringValue(data.ID),
+		Name:            types.StringValue(data.Name),
+		Description:     types.StringValue(data.Description),
+		CreatedAt:       types.StringValue(data.CreatedAt),
+		UpdatedAt:       types.StringValue(data.UpdatedAt),
+		SNAT:            types.StringValue(data.SNAT),
+		CIDRBlock:       types.StringValue(data.CIDRBlock),
+		Gateway:         types.StringValue(data.Gateway),
+		ConnectedVMs:    types.StringValue(data.ConnectedVMs),
+		NetworkType:     types.StringValue(data.NetworkType),
+		DatacenterId:    types.StringValue(data.Datacenter.ID),
+		Datacenter:      types.StringValue(data.Datacenter.Name),
+		Region:          types.StringValue(data.Region.Name),
+		Country:         types.StringValue(data.Country.Name),
+		DNSServers:      dnsServersList,
+		AllocationPools: MapAllocationPoolsToList(ctx, data.AllocationPools),
+		IPv6CIDRBlock:   stringValueOrNull(data.IPv6CIDRBlock),
+		IPv6Gateway:     stringValueOrNull(data.IPv6Gateway),
+		IPv6AddressMode: stringValueOrNull(data.IPv6AddressMode),
+		Routes:          MapRoutesToSet(ctx, data.Routes),
+	}
+}
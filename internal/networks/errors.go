@@ -2,20 +2,60 @@ package networks
 
 // Error summary constants
 const (
-	ErrSummaryMissingRequiredAttr = "Missing required attribute"
-	ErrSummaryInvalidAttr         = "Attribute is invalid"
+	ErrSummaryMissingRequiredAttr   = "Missing required attribute"
+	ErrSummaryInvalidAttr           = "Attribute is invalid"
+	ErrSummaryUnableToGetNetwork    = "Unable to get GPCN Network"
+	ErrSummaryUnableToListNetwork   = "Unable to list GPCN Networks"
+	ErrSummaryUnableToImportNetwork = "Unable to import GPCN Network"
+	ErrSummaryNetworkStillAttached  = "GPCN Network is attached to virtual machines"
+	ErrSummaryUnableToDetachNetwork = "Unable to detach GPCN Network from virtual machines"
 )
 
 // Error detail message templates
 const (
-	ErrDetailAttrRequiredForStandard      = "Attribute '%s' must be set when 'network_type' is 'standard'."
-	ErrDetailNotValidIPv4                 = "The attribute '%s' does not resolve to a valid IPv4 address"
-	ErrDetailNotValidIPv4WithValue        = "The attribute '%s' does not resolve to a valid IPv4 address. The value '%s' is not a valid IPv4 address"
-	ErrDetailNotInCIDRBlock               = "The attribute '%s' is not a valid IP address in the CIDR block"
-	ErrDetailNotValidCIDRBlock            = "The attribute '%s' does not contain a valid CIDR block"
-	ErrDetailCIDRBlockNotNetworkAddr      = "The attribute '%s' does not contain a valid CIDR block. The IP address is not the network address for the given mask"
-	ErrDetailCIDRBlockInvalidIP           = "The attribute '%s' does not contain a CIDR block with a valid IP address"
-	ErrDetailDNSInvalidDelimiter          = "The attribute '%s' must use comma-space (', ') as the delimiter between DNS server addresses. Example: '8.8.8.8, 8.8.4.4'"
-	ErrDetailDNSSpaceBeforeComma          = "The attribute '%s' must use comma-space (', ') as the delimiter. Space before comma is not allowed. Example: '8.8.8.8, 8.8.4.4'"
-	ErrDetailRemoveNetworkInterfaceFailed = "failed to detach network interface for ID: '%s' before deleting. Unable to delete a network still attached to a virtual machine"
+	ErrDetailAttrRequiredForStandard    = "Attribute '%s' must be set when 'network_type' is 'standard'."
+	ErrDetailAttrRequiresCIDRBlock      = "Attribute '%s' can only be enabled when 'cidr_block' is set."
+	ErrDetailUnableToDeriveDefaultRoute = "Unable to derive 'default_route' from 'cidr_block': %s"
+	ErrDetailCIDRPrefixLengthOutOfRange = "The attribute 'cidr_block' has a prefix length of /%d. Networks support prefix lengths from /%d to /%d"
+	ErrDetailAddressIsReserved          = "The attribute '%s' with value '%s' is the %s of the CIDR block '%s' and cannot be used"
+	ErrDetailDHCPRangeReversed          = "The DHCP range is reversed. '%s' with value '%s' comes after '%s' with value '%s'"
+	ErrDetailAllocationPoolsOverlap     = "The allocation pool '%s' - '%s' overlaps the allocation pool '%s' - '%s'. Allocation pools must not share addresses"
+	ErrDetailNotValidIPv6CIDRBlock      = "The attribute '%s' does not contain a valid IPv6 CIDR block"
+	ErrDetailIPv6PrefixLengthOutOfRange = "The attribute 'ipv6_cidr_block' has a prefix length of /%d. Networks support IPv6 prefix lengths from /%d to /%d"
+	ErrDetailSLAACRequiresPrefix64      = "The attribute 'ipv6_address_mode' is 'slaac', which requires 'ipv6_cidr_block' to have a prefix length of /64, not /%d"
+	ErrDetailIPv6DNSServerWithoutIPv6   = "The DNS server '%s' is an IPv6 address, which can only be served to networks with an 'ipv6_cidr_block'"
+	ErrDetailRoutesRequireCIDRBlock     = "Attribute 'routes' can only be set when 'cidr_block' is set, as the next hop of each route must lie within it."
+	ErrDetailRouteDestinationInvalid    = "The route destination '%s' is not a valid CIDR block. It must be the network address of an IPv4 or IPv6 CIDR block (e.g., 192.168.10.0/24)"
+	ErrDetailRouteDestinationIsDefault  = "The route destination '%s' is the default route. Use 'default_route' to change where traffic without a more specific route is sent"
+	ErrDetailRouteNextHopInvalid        = "The next hop '%s' of the route to '%s' is not a valid IP address"
+	ErrDetailRouteNextHopFamilyMismatch = "The next hop '%s' of the route to '%s' is not of the same IP version as the destination"
+	ErrDetailRouteNextHopNotInCIDRBlock = "The next hop '%s' of the route to '%s' is not within the %s '%s' of the network"
+	ErrDetailRouteNextHopIsReserved     = "The next hop '%s' of the route to '%s' is the %s of the 'cidr_block' '%s', which cannot be used as a next hop"
+	ErrDetailRouteNextHopRequiresIPv6   = "The route to '%s' is an IPv6 route, which can only be added to networks with an 'ipv6_cidr_block'"
+	ErrDetailRouteDestinationDuplicate  = "The route destination '%s' is used by more than one route. Each destination can only have one next hop"
+	ErrDetailNotValidIPv4               = "The attribute '%s' does not resolve to a valid IPv4 address"
+	ErrDetailNotValidIPWithValue        = "The attribute '%s' does not resolve to a valid IP address. The value '%s' is not a valid IPv4 or IPv6 address"
+	ErrDetailNotInCIDRBlock             = "The attribute '%s' is not a valid IP address in the CIDR block"
+	ErrDetailNotValidCIDRBlock          = "The attribute '%s' does not contain a valid CIDR block"
+	ErrDetailCIDRBlockNotNetworkAddr    = "The attribute '%s' does not contain a valid CIDR block. The IP address is not the network address for the given mask"
+	ErrDetailCIDRBlockInvalidIP         = "The attribute '%s' does not contain a CIDR block with a valid IP address"
+	ErrDetailNetworkStillAttached       = "The network with ID: '%s' was not deleted because it is attached to %d virtual machine(s), and 'detach_on_destroy' is false. Detach the network from these virtual machines, or set 'detach_on_destroy' to true to detach it automatically:\n%s"
+	ErrDetailOnlyNetworkOfVM            = "The network is the only network of the virtual machine, which must keep at least one network"
+	ErrDetailPrimaryNetworkOfVM         = "The network is the primary network of the virtual machine, which has %d other networks. Set 'primary_network_id' of the virtual machine to one of them before destroying the network"
+	ErrDetailSetPrimaryStatus           = "setting the network interface with ID: '%s' as primary failed with status code %d: %s"
+	ErrDetailSetPrimaryUnsuccessful     = "setting the network interface with ID: '%s' as primary failed: %s"
+	ErrDetailDetachNetworkFailed        = "The network with ID: '%s' was not deleted because it could not be detached from every virtual machine. Virtual machines it was detached from:\n%s\nVirtual machines it could not be detached from:\n%s"
+	ErrDetailUnableToGetNetworkWithID   = "Unable to get GPCN Network with ID: '%s'"
+	ErrDetailNetworkNameNotFound        = "No network named '%s' was found in the datacenter with ID: '%s'"
+	ErrDetailNetworkNameAmbiguous       = "%d networks named '%s' were found in the datacenter with ID: '%s'. Look the network up by 'id' instead. Matching IDs are: %s"
+)
+
+// Warning summary constants
+const (
+	WarnSummaryDetachedNetwork = "Detached GPCN Network from virtual machines"
+)
+
+// Warning detail message templates
+const (
+	WarnDetailDetachedNetwork = "Before deleting the network with ID: '%s', it was detached from these virtual machines:\n%s"
 )
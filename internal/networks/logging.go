@@ -14,6 +14,14 @@ const (
 	LogStartingGetNetworkWithID           = "Starting GetNetwork for network ID: %s"
 	LogSuccessfullyRetrievedNetworkWithID = "Successfully retrieved network with ID: %s"
 
+	// ListNetworks messages
+	LogStartingListNetworks       = "Starting ListNetworks"
+	LogSuccessfullyListedNetworks = "Successfully listed %d networks"
+
+	// GetNetworkByName messages
+	LogStartingGetNetworkByName           = "Starting GetNetworkByName for network name: %s"
+	LogSuccessfullyRetrievedNetworkByName = "Successfully retrieved network with name: %s"
+
 	// GetVirtualMachinesAttachedToNetworks messages
 	LogStartingGetVirtualMachinesAttachedToNetworks           = "Starting GetVirtualMachinesAttachedToNetworks for network ID: %s"
 	LogSuccessfullyRetrievedVirtualMachinesAttachedToNetworks = "Successfully retrieved virtual machines attached to network with ID: %s"
@@ -40,6 +48,10 @@ const (
 	LogSuccessfullyCompletedDeleteNetworkWithID = "Successfully completed DeleteNetwork for network ID: %s"
 	LogDeleteNetworkFailedRetrying              = "Delete GPCN Network failed for network ID: %s. Issuing retry number: %d. Max retries allowed: %d"
 
+	// Detach on destroy messages
+	LogDetachingNetworkFromVM        = "Detaching network with ID: %s from virtual machine with ID: %s"
+	LogSuccessfullyDetachedNetworkVM = "Successfully detached network with ID: %s from virtual machine with ID: %s"
+
 	// GetNetworkInterfaces messages
 	LogStartingGetNetworkInterfacesWithID        = "Starting GetNetworkInterfaces for Virtual Machine ID: %s"
 	LogSuccessfullyRetrievedAllNetworkInterfaces = "Successfully retrieved all network interfaces for Virtual Machine ID: %s"
@@ -48,11 +60,14 @@ const (
 	LogStartingAddNetworkInterfaceWithIDs   = "Starting AddNetworkInterface for Virtual Machine ID: %s with network ID: %s"
 	LogSuccessfullyAttachedNetworkInterface = "Successfully attached network interface"
 
-	// SetNextNetworkInterfaceToPrimary messages
-	LogStartingSetNextNetworkInterfaceToPrimary = "Starting SetNextNetworkInterfaceToPrimary for Virtual Machine ID: %s"
+	// SetNetworkInterfaceToPrimary messages
 	LogSettingNetworkInterfaceAsPrimary         = "Setting network interface with ID %s as primary"
 	LogSuccessfullySetNetworkInterfaceAsPrimary = "Successfully set network interface with ID %s as primary"
 
+	// SwitchPrimaryNetworkInterface messages
+	LogStartingSwitchPrimaryNetworkInterface       = "Starting SwitchPrimaryNetworkInterface for Virtual Machine ID: %s and network ID: %s"
+	LogSuccessfullySwitchedPrimaryNetworkInterface = "Successfully switched the primary network interface of Virtual Machine ID: %s to network ID: %s"
+
 	// RemoveNetworkInterface messages
 	LogStartingRemoveNetworkInterfaceWithIDs = "Starting RemoveNetworkInterface for Virtual Machine ID: %s with network interface ID: %s"
 	LogSuccessfullyRemovedNetworkInterface   = "Successfully removed network interface with ID: %s"
@@ -67,4 +82,16 @@ const (
 	LogSuccessfullyFinishedUpdateGPCNNetwork = "Successfully finished Update GPCN Network"
 	LogStartingDeleteGPCNNetwork             = "Starting Delete GPCN Network"
 	LogSuccessfullyFinishedDeleteGPCNNetwork = "Successfully finished Delete GPCN Network"
+	LogStartingModifyPlanGPCNNetwork         = "Starting ModifyPlan GPCN Network"
+	LogStartingUpgradeStateGPCNNetworkV0     = "Starting UpgradeState GPCN Network from schema version 0"
+
+	// Data source operation messages
+	LogStartingReadGPCNNetworkDataSource              = "Starting Read GPCN Network data source"
+	LogSuccessfullyFinishedReadGPCNNetworkDataSource  = "Successfully finished Read GPCN Network data source"
+	LogStartingReadGPCNNetworksDataSource             = "Starting Read GPCN Networks data source"
+	LogSuccessfullyFinishedReadGPCNNetworksDataSource = "Successfully finished Read GPCN Networks data source"
+
+	// List resource operation messages
+	LogStartingListGPCNNetworks             = "Starting List GPCN Networks list resource"
+	LogSuccessfullyFinishedListGPCNNetworks = "Successfully finished List GPCN Networks list resource"
 )
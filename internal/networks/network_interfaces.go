@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"slices"
 	"strings"
@@ -35,6 +36,9 @@ type ReadVirtualMachineNetworkDataResponse struct {
 	CIDRBlock        string `json:"cidrBlock"`
 	GatewayIP        string `json:"gatewayIp"`
 	NetworkType      string `json:"networkType"`
+	PrivateIPv6      string `json:"privateIpv6"`
+	IPv6CIDRBlock    string `json:"ipv6CidrBlock"`
+	IPv6GatewayIP    string `json:"ipv6GatewayIp"`
 }
 type ReadVirtualMachineNetworkDataResponseTF struct {
 	ID               types.String `tfsdk:"id"`
@@ -48,6 +52,9 @@ type ReadVirtualMachineNetworkDataResponseTF struct {
 	CIDRBlock        types.String `tfsdk:"cidr_block"`
 	GatewayIP        types.String `tfsdk:"gateway_ip"`
 	NetworkType      types.String `tfsdk:"network_type"`
+	PrivateIPv6      types.String `tfsdk:"private_ipv6"`
+	IPv6CIDRBlock    types.String `tfsdk:"ipv6_cidr_block"`
+	IPv6GatewayIP    types.String `tfsdk:"ipv6_gateway_ip"`
 }
 
 func (o ReadVirtualMachineNetworkDataResponseTF) AttrTypes() map[string]attr.Type {
@@ -63,15 +70,18 @@ func (o ReadVirtualMachineNetworkDataResponseTF) AttrTypes() map[string]attr.Typ
 		"cidr_block":        types.StringType,
 		"gateway_ip":        types.StringType,
 		"network_type":      types.StringType,
+		"private_ipv6":      types.StringType,
+		"ipv6_cidr_block":   types.StringType,
+		"ipv6_gateway_ip":   types.StringType,
 	}
 }
 
 type readNetworksToVMsResponse struct {
 	Success bool                            `json:"success"`
 	Message string                          `json:"message"`
-	Data    []readNetworksToVMsDataResponse `json:"data"`
+	Data    []ReadNetworksToVMsDataResponse `json:"data"`
 }
-type readNetworksToVMsDataResponse struct {
+type ReadNetworksToVMsDataResponse struct {
 	ID        string `json:"id"`
 	Name      string `json:"name"`
 	MachineId string `json:"machineId"`
@@ -149,6 +159,9 @@ func GetNetworkInterfaces(httpClient *http.Client, ctx context.Context, virtualM
 			CIDRBlock:        types.StringValue(inter.CIDRBlock),
 			GatewayIP:        types.StringValue(inter.GatewayIP),
 			NetworkType:      types.StringValue(inter.NetworkType),
+			PrivateIPv6:      stringValueOrNull(&inter.PrivateIPv6),
+			IPv6CIDRBlock:    stringValueOrNull(&inter.IPv6CIDRBlock),
+			IPv6GatewayIP:    stringValueOrNull(&inter.IPv6GatewayIP),
 		})
 	}
 
@@ -200,9 +213,8 @@ func AddNetworkInterface(httpClient *http.Client, ctx context.Context, virtualMa
 	return nil
 }
 
-// Attach a network interface to the virtual machine
-func SetNextNetworkInterfaceToPrimary(httpClient *http.Client, ctx context.Context, virtualMachineId string, allNetworkInterfaces []ReadVirtualMachineNetworkDataResponseTF) error {
-	tflog.Info(ctx, fmt.Sprintf(LogStartingSetNextNetworkInterfaceToPrimary, virtualMachineId))
+// Make the given network interface the primary interface of the virtual machine
+func SetNetworkInterfaceToPrimary(httpClient *http.Client, ctx context.Context, virtualMachineId, networkInterfaceId string) error {
 	updateNetworkInterfaceRequestBody := map[string]bool{
 		"setPrimary": true,
 	}
@@ -211,26 +223,81 @@ func SetNextNetworkInterfaceToPrimary(httpClient *http.Client, ctx context.Conte
 	if err != nil {
 		return errors.New("error marshaling the json request body GPCN Virtual Machines - Update Primary Interface")
 	}
-	// Find the next interface in the list that is not the previous primary
-	networkInterfaceIdx := slices.IndexFunc(allNetworkInterfaces, func(networkInterface ReadVirtualMachineNetworkDataResponseTF) bool {
-		return networkInterface.IsPrimary.ValueInt64() != 1
-	})
-	if networkInterfaceIdx < -1 {
-		return errors.New("no network interfaces found that were not marked as primary")
+	tflog.Info(ctx, fmt.Sprintf(LogSettingNetworkInterfaceAsPrimary, networkInterfaceId))
+	request, err := http.NewRequest("PUT", VIRTUAL_MACHINES_BASE_URL_V1+virtualMachineId+"/network-interfaces/"+networkInterfaceId, bytes.NewBuffer(jsonUpdateNetworkInterfaceRequestBody))
+	if err != nil {
+		return err
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
 	}
-	nextPrimaryNetworkInterfaceId := allNetworkInterfaces[networkInterfaceIdx].ID.ValueString()
-	tflog.Info(ctx, fmt.Sprintf(LogSettingNetworkInterfaceAsPrimary, nextPrimaryNetworkInterfaceId))
-	request, err := http.NewRequest("PUT", VIRTUAL_MACHINES_BASE_URL_V1+virtualMachineId+"/network-interfaces/"+nextPrimaryNetworkInterfaceId, bytes.NewBuffer(jsonUpdateNetworkInterfaceRequestBody))
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
 	if err != nil {
 		return err
 	}
 
-	_, err = httpClient.Do(request)
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf(ErrDetailSetPrimaryStatus, networkInterfaceId, response.StatusCode, string(body))
+	}
+
+	var setPrimaryResponse client.JobStatusSingularResponse
+	err = json.Unmarshal(body, &setPrimaryResponse)
+
 	if err != nil {
 		return err
 	}
 
-	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullySetNetworkInterfaceAsPrimary, nextPrimaryNetworkInterfaceId))
+	if !setPrimaryResponse.Success {
+		return fmt.Errorf(ErrDetailSetPrimaryUnsuccessful, networkInterfaceId, setPrimaryResponse.Message)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullySetNetworkInterfaceAsPrimary, networkInterfaceId))
+	return nil
+}
+
+// Make the interface on the given network the primary interface of the virtual machine. A public IP on the old primary
+// interface is released first, since only the primary interface can hold one, and is allocated again on the new primary
+// interface when movePublicIp is set
+func SwitchPrimaryNetworkInterface(httpClient *http.Client, ctx context.Context, virtualMachineId, primaryNetworkId string, movePublicIp bool, networkInterfaces []ReadVirtualMachineNetworkDataResponseTF) error {
+	tflog.Info(ctx, fmt.Sprintf(LogStartingSwitchPrimaryNetworkInterface, virtualMachineId, primaryNetworkId))
+	newPrimaryIdx := slices.IndexFunc(networkInterfaces, func(networkInterface ReadVirtualMachineNetworkDataResponseTF) bool {
+		return strings.EqualFold(networkInterface.NetworkID.ValueString(), primaryNetworkId)
+	})
+	if newPrimaryIdx < 0 {
+		return fmt.Errorf("no network interface found for network with ID %s", primaryNetworkId)
+	}
+	newPrimary := networkInterfaces[newPrimaryIdx]
+	if newPrimary.IsPrimary.ValueInt64() == 1 {
+		return nil
+	}
+
+	oldPrimaryIdx := slices.IndexFunc(networkInterfaces, func(networkInterface ReadVirtualMachineNetworkDataResponseTF) bool {
+		return networkInterface.IsPrimary.ValueInt64() == 1
+	})
+	if oldPrimaryIdx > -1 && networkInterfaces[oldPrimaryIdx].PublicIP.ValueString() != "" {
+		err := ReleasePublicIp(httpClient, ctx, virtualMachineId, networkInterfaces[oldPrimaryIdx].ID.ValueString())
+		if err != nil {
+			return fmt.Errorf("error releasing the public IP of the old primary interface: %w", err)
+		}
+	}
+
+	err := SetNetworkInterfaceToPrimary(httpClient, ctx, virtualMachineId, newPrimary.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	if movePublicIp && newPrimary.PublicIP.ValueString() == "" {
+		err = AllocatePublicIp(httpClient, ctx, virtualMachineId, newPrimary.ID.ValueString())
+		if err != nil {
+			return fmt.Errorf("error allocating a public IP on the new primary interface: %w", err)
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullySwitchedPrimaryNetworkInterface, virtualMachineId, primaryNetworkId))
 	return nil
 }
 
@@ -273,34 +340,6 @@ func RemoveNetworkInterface(httpClient *http.Client, ctx context.Context, virtua
 	return nil
 }
 
-func RemoveNetworkInterfaceByNetworkId(httpClient *http.Client, ctx context.Context, virtualMachineId, networkId string) error {
-	// Using the virtual machine id, find the corresponding networkId
-	// No GET :id endpoint, use the list and find it
-	networkInterfaces, err := GetNetworkInterfaces(httpClient, ctx, virtualMachineId)
-	if err != nil {
-		return err
-	}
-
-	var networkInterfaceId string
-	for _, inter := range networkInterfaces {
-		if inter.NetworkID.ValueString() == networkId {
-			networkInterfaceId = inter.ID.ValueString()
-		}
-	}
-	// If the networkId doesn't have a corresponding interface, something went wrong
-	if networkInterfaceId == "" {
-		return errors.New(ErrDetailRemoveNetworkInterfaceFailed)
-	}
-
-	// If it does, remove it
-	err = RemoveNetworkInterface(httpClient, ctx, virtualMachineId, networkInterfaceId)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func AllocatePublicIp(httpClient *http.Client, ctx context.Context, virtualMachineId, networkInterfaceId string) error {
 	tflog.Info(ctx, fmt.Sprintf(LogStartingAllocatePublicIp, virtualMachineId, networkInterfaceId))
 	request, err := http.NewRequest("POST", VIRTUAL_MACHINES_BASE_URL_V1+virtualMachineId+"/network-interfaces/"+networkInterfaceId+"/public-ip", nil)
@@ -371,52 +410,79 @@ func ReleasePublicIp(httpClient *http.Client, ctx context.Context, virtualMachin
 	return nil
 }
 
-// Helper funtion to consolidate logic for adding and removing network interfaces for a virtual machine
-func UpdateNetworkInterfaces(httpClient *http.Client, ctx context.Context, vmId string, oldNetworksList, newNetworksList []string, networkInterfaces []ReadVirtualMachineNetworkDataResponseTF) error {
+// Helper funtion to consolidate logic for adding and removing network interfaces for a virtual machine. Removals and then additions
+// run in parallel, and the interface on primaryNetworkId is made the primary before the old primary interface is removed. Failures
+// are returned keyed by network ID, and a failure does not stop the other networks from being updated
+func UpdateNetworkInterfaces(httpClient *http.Client, ctx context.Context, vmId string, oldNetworksList, newNetworksList []string, primaryNetworkId string, movePublicIp bool, networkInterfaces []ReadVirtualMachineNetworkDataResponseTF) map[string]error {
 	tflog.Info(ctx, "NetworkIds have changed, performing detaches and attaches in that order")
 
 	addedValues, removedValues := helpers.CheckListForDifferences(oldNetworksList, newNetworksList)
 	tflog.Info(ctx, fmt.Sprintf("NetworkIds to be removed are: [%s]", helpers.JoinStrings(removedValues)))
 	tflog.Info(ctx, fmt.Sprintf("NetworkIds to be added are: [%s]", helpers.JoinStrings(addedValues)))
 
-	// Check if any interfaces slated to be removed are the primary interface. If so, make the next interface available the primary
-	for _, val := range removedValues {
-		interfaceIdx := slices.IndexFunc(networkInterfaces, func(data ReadVirtualMachineNetworkDataResponseTF) bool {
-			return strings.EqualFold(data.NetworkID.ValueString(), val)
+	findInterface := func(networkId string) int {
+		return slices.IndexFunc(networkInterfaces, func(data ReadVirtualMachineNetworkDataResponseTF) bool {
+			return strings.EqualFold(data.NetworkID.ValueString(), networkId)
 		})
-		if interfaceIdx > -1 && networkInterfaces[interfaceIdx].IsPrimary.ValueInt64() == 1 {
-			// Issue a call to set the next interface to be the primary
-			err := SetNextNetworkInterfaceToPrimary(httpClient, ctx, vmId, networkInterfaces)
-			if err != nil {
-				return fmt.Errorf("error replacing primary interface: %w", err)
-			}
-			break
-		}
 	}
 
-	// Do removals first, since there is a cap of 5 networks
+	// Do removals first, since there is a cap of 5 networks. The primary interface cannot be removed until another one has taken over
+	var oldPrimaryNetworkId string
+	var removedNetworkIds []string
 	for _, val := range removedValues {
-		interfaceIdx := slices.IndexFunc(networkInterfaces, func(data ReadVirtualMachineNetworkDataResponseTF) bool {
-			return strings.EqualFold(data.NetworkID.ValueString(), val)
-		})
+		interfaceIdx := findInterface(val)
 		if interfaceIdx < 0 {
 			continue
 		}
-		tflog.Info(ctx, fmt.Sprintf("Removing network interface for ID: %s", val))
-		err := RemoveNetworkInterface(httpClient, ctx, vmId, networkInterfaces[interfaceIdx].ID.ValueString())
-		if err != nil {
-			return fmt.Errorf("error removing network interface with ID %s: %w", val, err)
+		if networkInterfaces[interfaceIdx].IsPrimary.ValueInt64() == 1 {
+			oldPrimaryNetworkId = val
+			continue
 		}
+		removedNetworkIds = append(removedNetworkIds, val)
 	}
+	failures := helpers.RunForEachID(removedNetworkIds, helpers.MAX_PARALLEL_ATTACHMENT_JOBS, func(networkId string) error {
+		tflog.Info(ctx, fmt.Sprintf("Removing network interface for ID: %s", networkId))
+		err := RemoveNetworkInterface(httpClient, ctx, vmId, networkInterfaces[findInterface(networkId)].ID.ValueString())
+		if err != nil {
+			return fmt.Errorf("error removing network interface: %w", err)
+		}
+		return nil
+	})
 
 	// Add new network interfaces
-	for _, val := range addedValues {
-		tflog.Info(ctx, fmt.Sprintf("Adding network interface for ID: %s", val))
-		err := AddNetworkInterface(httpClient, ctx, vmId, val)
+	maps.Copy(failures, helpers.RunForEachID(addedValues, helpers.MAX_PARALLEL_ATTACHMENT_JOBS, func(networkId string) error {
+		tflog.Info(ctx, fmt.Sprintf("Adding network interface for ID: %s", networkId))
+		err := AddNetworkInterface(httpClient, ctx, vmId, networkId)
 		if err != nil {
-			return fmt.Errorf("error adding network interface with ID %s: %w", val, err)
+			return fmt.Errorf("error adding network interface: %w", err)
+		}
+		return nil
+	}))
+
+	// The new primary may have just been attached, so the interfaces are read again to find it. The old primary is only removed
+	// once another interface has taken over
+	if primaryNetworkId != "" && failures[primaryNetworkId] == nil {
+		currentNetworkInterfaces, err := GetNetworkInterfaces(httpClient, ctx, vmId)
+		if err == nil {
+			err = SwitchPrimaryNetworkInterface(httpClient, ctx, vmId, primaryNetworkId, movePublicIp, currentNetworkInterfaces)
+		}
+		if err != nil {
+			failures[primaryNetworkId] = fmt.Errorf("error replacing primary interface: %w", err)
+			return failures
 		}
 	}
 
-	return nil
+	if oldPrimaryNetworkId != "" {
+		if failures[primaryNetworkId] != nil {
+			failures[oldPrimaryNetworkId] = errors.New("the network interface was not removed, since it is still the primary interface")
+			return failures
+		}
+		tflog.Info(ctx, fmt.Sprintf("Removing network interface for ID: %s", oldPrimaryNetworkId))
+		err := RemoveNetworkInterface(httpClient, ctx, vmId, networkInterfaces[findInterface(oldPrimaryNetworkId)].ID.ValueString())
+		if err != nil {
+			failures[oldPrimaryNetworkId] = fmt.Errorf("error removing network interface: %w", err)
+		}
+	}
+
+	return failures
 }
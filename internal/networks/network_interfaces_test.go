@@ -0,0 +1,61 @@
+package networks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"terraform-provider-gpcn/internal/client"
+)
+
+func TestSetNetworkInterfaceToPrimary(t *testing.T) {
+	testCases := map[string]struct {
+		handler       http.HandlerFunc
+		expectedError string
+	}{
+		"success": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "PUT" || r.URL.Path != VIRTUAL_MACHINES_BASE_URL_V1+"vm/network-interfaces/interface" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				fmt.Fprint(w, `{"success":true,"message":"","data":{}}`)
+			},
+		},
+		"status code": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"success":false,"message":"interface is busy"}`)
+			},
+			expectedError: "status code 400",
+		},
+		"unsuccessful response": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"success":false,"message":"interface not found"}`)
+			},
+			expectedError: "as primary failed: interface not found",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(testCase.handler)
+			defer server.Close()
+			httpClient, _ := client.NewHttpClient(server.URL, "key")
+
+			err := SetNetworkInterfaceToPrimary(httpClient, context.Background(), "vm", "interface")
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Fatalf("expected error containing %q, got: %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
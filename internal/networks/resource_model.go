@@ -2,27 +2,39 @@ package networks
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type ResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Description      types.String `tfsdk:"description"`
-	CreatedTime      types.String `tfsdk:"created_time"`
-	LastUpdated      types.String `tfsdk:"last_updated"`
-	SNAT             types.String `tfsdk:"snat"`
-	CIDRBlock        types.String `tfsdk:"cidr_block"`
-	Gateway          types.String `tfsdk:"gateway"`
-	ConnectedVMs     types.String `tfsdk:"connected_vms"`
-	NetworkType      types.String `tfsdk:"network_type"`
-	DatacenterId     types.String `tfsdk:"datacenter_id"`
-	Location         types.Map    `tfsdk:"location"`
-	DNSServers       types.String `tfsdk:"dns_servers"`
-	DHCPStartAddress types.String `tfsdk:"dhcp_start_address"`
-	DHCPEndAddress   types.String `tfsdk:"dhcp_end_address"`
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	CreatedTime         types.String `tfsdk:"created_time"`
+	LastUpdated         types.String `tfsdk:"last_updated"`
+	SNAT                types.String `tfsdk:"snat"`
+	CIDRBlock           types.String `tfsdk:"cidr_block"`
+	Gateway             types.String `tfsdk:"gateway"`
+	ConnectedVMs        types.String `tfsdk:"connected_vms"`
+	NetworkType         types.String `tfsdk:"network_type"`
+	DatacenterId        types.String `tfsdk:"datacenter_id"`
+	Location            types.Map    `tfsdk:"location"`
+	DNSServers          types.List   `tfsdk:"dns_servers"`
+	DHCPStartAddress    types.String `tfsdk:"dhcp_start_address"`
+	DHCPEndAddress      types.String `tfsdk:"dhcp_end_address"`
+	AllocationPools     types.List   `tfsdk:"allocation_pools"`
+	DefaultRoute        types.String `tfsdk:"default_route"`
+	DefaultRouteEnabled types.Bool   `tfsdk:"default_route_enabled"`
+	DHCPEnabled         types.Bool   `tfsdk:"dhcp_enabled"`
+	ServeDNSEnabled     types.Bool   `tfsdk:"serve_dns_enabled"`
+	SNATEnabled         types.Bool   `tfsdk:"snat_enabled"`
+	IPv6CIDRBlock       types.String `tfsdk:"ipv6_cidr_block"`
+	IPv6Gateway         types.String `tfsdk:"ipv6_gateway"`
+	IPv6AddressMode     types.String `tfsdk:"ipv6_address_mode"`
+	Routes              types.Set    `tfsdk:"routes"`
+	DetachOnDestroy     types.Bool   `tfsdk:"detach_on_destroy"`
 }
 
 // Update the plan or state with new values from the GET response
@@ -33,7 +45,89 @@ func MapNetworkResponseToModel(ctx context.Context, response *readNetworkRespons
 	model.CIDRBlock = types.StringValue(response.Data.CIDRBlock)
 	model.Gateway = types.StringValue(response.Data.Gateway)
 	model.ConnectedVMs = types.StringValue(response.Data.ConnectedVMs)
-	model.DNSServers = types.StringValue(response.Data.DNSServers)
+	model.DNSServers, _ = types.ListValueFrom(ctx, types.StringType, ParseDNSServers(response.Data.DNSServers))
+
+	// Arguments are only unset after an import, in which case they are taken from the API
+	if model.Name.IsNull() {
+		model.Name = types.StringValue(response.Data.Name)
+	}
+	if model.NetworkType.IsNull() {
+		model.NetworkType = types.StringValue(response.Data.NetworkType)
+	}
+	if model.DatacenterId.IsNull() {
+		model.DatacenterId = types.StringValue(response.Data.Datacenter.ID)
+	}
+	if model.DetachOnDestroy.IsNull() {
+		model.DetachOnDestroy = types.BoolValue(false)
+	}
+
+	// The routing and DHCP toggles are only refreshed when the API reports them
+	if response.Data.DefaultRoute != nil && *response.Data.DefaultRoute != "" {
+		model.DefaultRoute = types.StringValue(*response.Data.DefaultRoute)
+	}
+	if response.Data.DefaultRouteEnabled != nil {
+		model.DefaultRouteEnabled = types.BoolValue(*response.Data.DefaultRouteEnabled)
+	}
+	if response.Data.DHCPServerEnabled != nil {
+		model.DHCPEnabled = types.BoolValue(*response.Data.DHCPServerEnabled)
+	}
+	if response.Data.ServeDNSServersEnabled != nil {
+		model.ServeDNSEnabled = types.BoolValue(*response.Data.ServeDNSServersEnabled)
+	}
+	if response.Data.SNATEnabled != nil {
+		model.SNATEnabled = types.BoolValue(*response.Data.SNATEnabled)
+	}
+
+	// IPv6 is only refreshed when the API reports it. An empty value means the network is IPv4-only
+	if response.Data.IPv6CIDRBlock != nil {
+		model.IPv6CIDRBlock = stringValueOrNull(response.Data.IPv6CIDRBlock)
+	}
+	if response.Data.IPv6Gateway != nil {
+		model.IPv6Gateway = stringValueOrNull(response.Data.IPv6Gateway)
+	}
+	if response.Data.IPv6AddressMode != nil {
+		model.IPv6AddressMode = stringValueOrNull(response.Data.IPv6AddressMode)
+	}
+	if model.IPv6Gateway.IsUnknown() {
+		model.IPv6Gateway = types.StringNull()
+	}
+	if model.IPv6AddressMode.IsUnknown() {
+		model.IPv6AddressMode = types.StringNull()
+		if !model.IPv6CIDRBlock.IsNull() {
+			model.IPv6AddressMode = types.StringValue(IPV6_ADDRESS_MODE_SLAAC)
+		}
+	}
+
+	// Static routes are only refreshed when the API reports them. A network without routes keeps routes unset
+	if response.Data.Routes != nil {
+		model.Routes = types.SetNull(types.ObjectType{AttrTypes: RouteTF{}.AttrTypes()})
+		if len(response.Data.Routes) > 0 {
+			model.Routes = MapRoutesToSet(ctx, response.Data.Routes)
+		}
+	}
+
+	// Settings that were still unknown at plan time follow the network as it was created
+	isStandardNetwork := types.BoolValue(response.Data.NetworkType == NETWORK_TYPE_STANDARD)
+	if model.DefaultRouteEnabled.IsUnknown() {
+		model.DefaultRouteEnabled = isStandardNetwork
+	}
+	if model.DHCPEnabled.IsUnknown() {
+		model.DHCPEnabled = isStandardNetwork
+	}
+	if model.ServeDNSEnabled.IsUnknown() {
+		model.ServeDNSEnabled = isStandardNetwork
+	}
+	if model.SNATEnabled.IsUnknown() {
+		model.SNATEnabled = isStandardNetwork
+	}
+	if model.DefaultRoute.IsUnknown() {
+		gateway, err := DefaultGatewayForCIDR(response.Data.CIDRBlock)
+		if err != nil {
+			model.DefaultRoute = types.StringNull()
+		} else {
+			model.DefaultRoute = types.StringValue(gateway)
+		}
+	}
 
 	// Construct time entries
 	createdTime, err := time.Parse(time.RFC3339, response.Data.CreatedAt)
@@ -56,11 +150,160 @@ func MapNetworkResponseToModel(ctx context.Context, response *readNetworkRespons
 		"datacenter": response.Data.Datacenter.Name,
 	})
 
-	// Construct the DHCPStart and EndAddresses
-	isStandardNetwork := model.NetworkType == types.StringValue("standard")
+	// Construct the allocation pools. The DHCP start and end addresses are a shorthand for a single pool, so they are only
+	// refreshed when the shorthand is in use, or after an import, and are cleared when the network has drifted to several pools
+	isImported := model.AllocationPools.IsNull()
+	model.AllocationPools = MapAllocationPoolsToList(ctx, response.Data.AllocationPools)
+	if model.NetworkType.ValueString() == NETWORK_TYPE_STANDARD && (isImported || !model.DHCPStartAddress.IsNull()) {
+		if len(response.Data.AllocationPools) == 1 {
+			model.DHCPStartAddress = types.StringValue(response.Data.AllocationPools[0].Start)
+			model.DHCPEndAddress = types.StringValue(response.Data.AllocationPools[0].End)
+		} else if !isImported {
+			model.DHCPStartAddress = types.StringNull()
+			model.DHCPEndAddress = types.StringNull()
+		}
+	}
+
+	return model
+}
+
+// Missing or empty strings in the API response stand for unset attributes
+func stringValueOrNull(value *string) types.String {
+	if value == nil || *value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
+
+// Convert the allocation pools of the API into the allocation_pools list
+func MapAllocationPoolsToList(ctx context.Context, pools []readNetworkDataAllocationPoolResponse) types.List {
+	allocationPools := []AllocationPoolTF{}
+	for _, pool := range pools {
+		allocationPools = append(allocationPools, AllocationPoolTF{
+			Start: types.StringValue(pool.Start),
+			End:   types.StringValue(pool.End),
+		})
+	}
+	allocationPoolsList, _ := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AllocationPoolTF{}.AttrTypes()}, allocationPools)
+	return allocationPoolsList
+}
+
+// Convert the static routes of the API into the routes set
+func MapRoutesToSet(ctx context.Context, routes []readNetworkDataRouteResponse) types.Set {
+	networkRoutes := []RouteTF{}
+	for _, route := range routes {
+		networkRoutes = append(networkRoutes, RouteTF{
+			Destination: types.StringValue(route.Destination),
+			NextHop:     types.StringValue(route.NextHop),
+		})
+	}
+	routesSet, _ := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: RouteTF{}.AttrTypes()}, networkRoutes)
+	return routesSet
+}
+
+// Build the static routes to send to the API. An empty list removes every route
+func RoutesFromModel(ctx context.Context, model ResourceModel) []readNetworkDataRouteResponse {
+	routes := []readNetworkDataRouteResponse{}
+	var networkRoutes []RouteTF
+	model.Routes.ElementsAs(ctx, &networkRoutes, false)
+	for _, route := range networkRoutes {
+		routes = append(routes, readNetworkDataRouteResponse{Destination: route.Destination.ValueString(), NextHop: route.NextHop.ValueString()})
+	}
+	return routes
+}
+
+// Build the allocation pools to send to the API. allocation_pools takes precedence over the DHCP start and end shorthand
+func AllocationPoolsFromModel(ctx context.Context, model ResourceModel) []readNetworkDataAllocationPoolResponse {
+	pools := []readNetworkDataAllocationPoolResponse{}
+	if !model.AllocationPools.IsNull() && !model.AllocationPools.IsUnknown() {
+		var allocationPools []AllocationPoolTF
+		model.AllocationPools.ElementsAs(ctx, &allocationPools, false)
+		for _, pool := range allocationPools {
+			pools = append(pools, readNetworkDataAllocationPoolResponse{Start: pool.Start.ValueString(), End: pool.End.ValueString()})
+		}
+		return pools
+	}
+	if !model.DHCPStartAddress.IsNull() && !model.DHCPEndAddress.IsNull() {
+		pools = append(pools, readNetworkDataAllocationPoolResponse{Start: model.DHCPStartAddress.ValueString(), End: model.DHCPEndAddress.ValueString()})
+	}
+	return pools
+}
+
+// The API sends and receives DNS servers as a single string delimited by a comma and a space
+func ParseDNSServers(dnsServers string) []string {
+	servers := []string{}
+	for server := range strings.SplitSeq(dnsServers, ",") {
+		server = strings.TrimSpace(server)
+		if server != "" {
+			servers = append(servers, server)
+		}
+	}
+	return servers
+}
+
+// Convert the dns_servers list into the delimited string expected by the API
+func FormatDNSServers(ctx context.Context, dnsServers types.List) string {
+	var servers []string
+	dnsServers.ElementsAs(ctx, &servers, false)
+	return strings.Join(servers, ", ")
+}
+
+// Build a model for a network returned by the collection endpoint. Used by the list resource, where there is no plan or state
+func MapNetworkDataToModel(ctx context.Context, data readNetworkDataResponse) ResourceModel {
+	return MapNetworkResponseToModel(ctx, &readNetworkResponse{Data: data}, ResourceModel{
+		Routes: types.SetNull(types.ObjectType{AttrTypes: RouteTF{}.AttrTypes()}),
+	})
+}
+
+// Model of the network as stored in state by schema version 0, when dns_servers was a delimited string
+type ResourceModelV0 struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	CreatedTime      types.String `tfsdk:"created_time"`
+	LastUpdated      types.String `tfsdk:"last_updated"`
+	SNAT             types.String `tfsdk:"snat"`
+	CIDRBlock        types.String `tfsdk:"cidr_block"`
+	Gateway          types.String `tfsdk:"gateway"`
+	ConnectedVMs     types.String `tfsdk:"connected_vms"`
+	NetworkType      types.String `tfsdk:"network_type"`
+	DatacenterId     types.String `tfsdk:"datacenter_id"`
+	Location         types.Map    `tfsdk:"location"`
+	DNSServers       types.String `tfsdk:"dns_servers"`
+	DHCPStartAddress types.String `tfsdk:"dhcp_start_address"`
+	DHCPEndAddress   types.String `tfsdk:"dhcp_end_address"`
+}
+
+// Upgrade a schema version 0 state. Routing and DHCP settings did not exist yet, so they are set to what version 0 sent to the API
+func UpgradeResourceModelV0(ctx context.Context, prior ResourceModelV0) ResourceModel {
+	model := ResourceModel{
+		ID:               prior.ID,
+		Name:             prior.Name,
+		Description:      prior.Description,
+		CreatedTime:      prior.CreatedTime,
+		LastUpdated:      prior.LastUpdated,
+		SNAT:             prior.SNAT,
+		CIDRBlock:        prior.CIDRBlock,
+		Gateway:          prior.Gateway,
+		ConnectedVMs:     prior.ConnectedVMs,
+		NetworkType:      prior.NetworkType,
+		DatacenterId:     prior.DatacenterId,
+		Location:         prior.Location,
+		DHCPStartAddress: prior.DHCPStartAddress,
+		DHCPEndAddress:   prior.DHCPEndAddress,
+	}
+	model.DNSServers, _ = types.ListValueFrom(ctx, types.StringType, ParseDNSServers(prior.DNSServers.ValueString()))
+	model.AllocationPools = MapAllocationPoolsToList(ctx, AllocationPoolsFromModel(ctx, model))
+	model.Routes = types.SetNull(types.ObjectType{AttrTypes: RouteTF{}.AttrTypes()})
+
+	isStandardNetwork := prior.NetworkType.ValueString() == NETWORK_TYPE_STANDARD
+	model.DefaultRouteEnabled = types.BoolValue(isStandardNetwork)
+	model.DHCPEnabled = types.BoolValue(isStandardNetwork)
+	model.ServeDNSEnabled = types.BoolValue(isStandardNetwork)
+	model.SNATEnabled = types.BoolValue(isStandardNetwork)
+	model.DefaultRoute = types.StringNull()
 	if isStandardNetwork {
-		model.DHCPStartAddress = types.StringValue(response.Data.AllocationPools[0].Start)
-		model.DHCPEndAddress = types.StringValue(response.Data.AllocationPools[0].End)
+		model.DefaultRoute = types.StringValue(LEGACY_DEFAULT_ROUTE)
 	}
 
 	return model
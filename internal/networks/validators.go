@@ -1,12 +1,18 @@
 package networks
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"net"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 /*
@@ -19,62 +25,74 @@ import (
 type StandardNetworkValidator struct{}
 
 func (v StandardNetworkValidator) Description(ctx context.Context) string {
-	return "Ensures attributes 'cidr_block', 'dhcp_start_address', 'dhcp_end_address', and 'dns_servers' are present when attribute 'network_type' is set to 'standard'."
+	return "Ensures attribute 'cidr_block' is present when attribute 'network_type' is set to 'standard', together with 'dhcp_start_address' and 'dhcp_end_address' unless 'dhcp_enabled' is false or 'allocation_pools' is set, and 'dns_servers' unless 'serve_dns_enabled' is false."
 }
 func (v StandardNetworkValidator) MarkdownDescription(ctx context.Context) string {
-	return "Ensures attributes 'cidr_block', 'dhcp_start_address', 'dhcp_end_address', and 'dns_servers' are present when attribute 'network_type' is set to 'standard'."
+	return "Ensures attribute 'cidr_block' is present when attribute 'network_type' is set to 'standard', together with 'dhcp_start_address' and 'dhcp_end_address' unless 'dhcp_enabled' is false or 'allocation_pools' is set, and 'dns_servers' unless 'serve_dns_enabled' is false."
 }
 func (v StandardNetworkValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	response.Diagnostics.Append(v.validate(ctx, request.Config)...)
+}
+func (v StandardNetworkValidator) ValidateList(ctx context.Context, request validator.ListRequest, response *validator.ListResponse) {
+	response.Diagnostics.Append(v.validate(ctx, request.Config)...)
+}
+func (v StandardNetworkValidator) validate(ctx context.Context, requestConfig tfsdk.Config) diag.Diagnostics {
 	// Access the full configuration to check other attributes
 	var config ResourceModel
-	diags := request.Config.Get(ctx, &config)
-	response.Diagnostics.Append(diags...)
-	if response.Diagnostics.HasError() {
-		return
+	diags := requestConfig.Get(ctx, &config)
+	if diags.HasError() {
+		return diags
 	}
 
 	if config.NetworkType.ValueString() == "standard" && config.CIDRBlock.IsNull() {
-		response.Diagnostics.AddError(
+		diags.AddError(
 			ErrSummaryMissingRequiredAttr,
 			fmt.Sprintf(ErrDetailAttrRequiredForStandard, "cidr_block"),
 		)
 	}
-	if config.NetworkType.ValueString() == "standard" && config.DHCPStartAddress.IsNull() {
-		response.Diagnostics.AddError(
+	// DHCP and DNS settings are only needed when the network serves them. Unknown toggles are treated as enabled
+	dhcpEnabled := !config.DHCPEnabled.Equal(types.BoolValue(false))
+	serveDNSEnabled := !config.ServeDNSEnabled.Equal(types.BoolValue(false))
+	// allocation_pools replaces the DHCP start and end shorthand
+	dhcpEnabled = dhcpEnabled && config.AllocationPools.IsNull()
+	if config.NetworkType.ValueString() == "standard" && dhcpEnabled && config.DHCPStartAddress.IsNull() {
+		diags.AddError(
 			ErrSummaryMissingRequiredAttr,
 			fmt.Sprintf(ErrDetailAttrRequiredForStandard, "dhcp_start_address"),
 		)
 	}
-	if config.NetworkType.ValueString() == "standard" && config.DHCPEndAddress.IsNull() {
-		response.Diagnostics.AddError(
+	if config.NetworkType.ValueString() == "standard" && dhcpEnabled && config.DHCPEndAddress.IsNull() {
+		diags.AddError(
 			ErrSummaryMissingRequiredAttr,
 			fmt.Sprintf(ErrDetailAttrRequiredForStandard, "dhcp_end_address"),
 		)
 	}
-	if config.NetworkType.ValueString() == "standard" && config.DNSServers.IsNull() {
-		response.Diagnostics.AddError(
+	if config.NetworkType.ValueString() == "standard" && serveDNSEnabled && config.DNSServers.IsNull() {
+		diags.AddError(
 			ErrSummaryMissingRequiredAttr,
 			fmt.Sprintf(ErrDetailAttrRequiredForStandard, "dns_servers"),
 		)
 	}
+
+	return diags
 }
 
 /*
 *
 
-	Custom validator for asserting attributes are a valid IPv4 address
+	Custom validator for asserting routing and DHCP features are only enabled on networks with a CIDR block
 
 *
 */
-type IpAddressValidator struct{}
+type CIDRBlockRequiredValidator struct{}
 
-func (v IpAddressValidator) Description(ctx context.Context) string {
-	return "Ensures attribute resolves to a valid IPv4 address"
+func (v CIDRBlockRequiredValidator) Description(ctx context.Context) string {
+	return "Ensures attribute 'cidr_block' is present when the attribute is set to true"
 }
-func (v IpAddressValidator) MarkdownDescription(ctx context.Context) string {
-	return "Ensures attribute resolves to a valid IPv4 address"
+func (v CIDRBlockRequiredValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures attribute 'cidr_block' is present when the attribute is set to true"
 }
-func (v IpAddressValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+func (v CIDRBlockRequiredValidator) ValidateBool(ctx context.Context, request validator.BoolRequest, response *validator.BoolResponse) {
 	// Access the full configuration to check other attributes
 	var config ResourceModel
 	diags := request.Config.Get(ctx, &config)
@@ -83,7 +101,31 @@ func (v IpAddressValidator) ValidateString(ctx context.Context, request validato
 		return
 	}
 
-	// Check for optional case
+	if request.ConfigValue.ValueBool() && config.CIDRBlock.IsNull() {
+		response.Diagnostics.AddError(
+			ErrSummaryMissingRequiredAttr,
+			fmt.Sprintf(ErrDetailAttrRequiresCIDRBlock, request.Path.Expression().String()),
+		)
+	}
+}
+
+/*
+*
+
+	Custom validator for asserting attributes are a valid IPv4 address
+
+*
+*/
+type IpAddressValidator struct{}
+
+func (v IpAddressValidator) Description(ctx context.Context) string {
+	return "Ensures attribute resolves to a valid IPv4 address"
+}
+func (v IpAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures attribute resolves to a valid IPv4 address"
+}
+func (v IpAddressValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	// Check for optional case. Whether the address fits the CIDR block is checked by AddressRangeConfigValidator
 	if request.ConfigValue.ValueString() == "" {
 		return
 	}
@@ -104,25 +146,51 @@ func (v IpAddressValidator) ValidateString(ctx context.Context, request validato
 		)
 		return
 	}
+}
 
-	// Verify the attribute is a valid part of the CIDR block
-	if config.CIDRBlock.IsNull() {
+/*
+*
+
+	Custom validator for asserting attributes are a valid IP address
+
+*
+*/
+type CIDRValidator struct{}
+
+func (v CIDRValidator) Description(ctx context.Context) string {
+	return "Ensures attribute resolves to a valid CIDR address"
+}
+func (v CIDRValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures attribute resolves to a valid CIDR address"
+}
+func (v CIDRValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	// Check for optional case
+	if request.ConfigValue.ValueString() == "" {
 		return
 	}
 
-	_, parsedIpNet, err := net.ParseCIDR(config.CIDRBlock.ValueString())
+	parsedIP, parsedIpNet, err := net.ParseCIDR(request.ConfigValue.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError(
 			ErrSummaryInvalidAttr,
-			fmt.Sprintf(ErrDetailNotInCIDRBlock, request.Path.Expression().String()),
+			fmt.Sprintf(ErrDetailNotValidCIDRBlock, request.Path.Expression().String()),
 		)
 		return
 	}
 
-	if !parsedIpNet.Contains(parsedIPAddress) {
+	if !parsedIP.Equal(parsedIpNet.IP) {
 		response.Diagnostics.AddError(
 			ErrSummaryInvalidAttr,
-			fmt.Sprintf(ErrDetailNotInCIDRBlock, request.Path.Expression().String()),
+			fmt.Sprintf(ErrDetailCIDRBlockNotNetworkAddr, request.Path.Expression().String()),
+		)
+		return
+	}
+
+	parsedIPv4 := parsedIP.To4()
+	if parsedIPv4 == nil {
+		response.Diagnostics.AddError(
+			ErrSummaryInvalidAttr,
+			fmt.Sprintf(ErrDetailCIDRBlockInvalidIP, request.Path.Expression().String()),
 		)
 		return
 	}
@@ -131,29 +199,29 @@ func (v IpAddressValidator) ValidateString(ctx context.Context, request validato
 /*
 *
 
-	Custom validator for asserting attributes are a valid IP address
+	Custom validator for asserting attributes are a valid IPv6 CIDR block
 
 *
 */
-type CIDRValidator struct{}
+type IPv6CIDRValidator struct{}
 
-func (v CIDRValidator) Description(ctx context.Context) string {
-	return "Ensures attribute resolves to a valid CIDR address"
+func (v IPv6CIDRValidator) Description(ctx context.Context) string {
+	return "Ensures attribute resolves to a valid IPv6 CIDR block with a supported prefix length"
 }
-func (v CIDRValidator) MarkdownDescription(ctx context.Context) string {
-	return "Ensures attribute resolves to a valid CIDR address"
+func (v IPv6CIDRValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures attribute resolves to a valid IPv6 CIDR block with a supported prefix length"
 }
-func (v CIDRValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+func (v IPv6CIDRValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
 	// Check for optional case
 	if request.ConfigValue.ValueString() == "" {
 		return
 	}
 
 	parsedIP, parsedIpNet, err := net.ParseCIDR(request.ConfigValue.ValueString())
-	if err != nil {
+	if err != nil || parsedIP.To4() != nil {
 		response.Diagnostics.AddError(
 			ErrSummaryInvalidAttr,
-			fmt.Sprintf(ErrDetailNotValidCIDRBlock, request.Path.Expression().String()),
+			fmt.Sprintf(ErrDetailNotValidIPv6CIDRBlock, request.Path.Expression().String()),
 		)
 		return
 	}
@@ -166,33 +234,60 @@ func (v CIDRValidator) ValidateString(ctx context.Context, request validator.Str
 		return
 	}
 
-	parsedIPv4 := parsedIP.To4()
-	if parsedIPv4 == nil {
+	prefixLength, _ := parsedIpNet.Mask.Size()
+	if prefixLength < MIN_IPV6_CIDR_PREFIX_LENGTH || prefixLength > MAX_IPV6_CIDR_PREFIX_LENGTH {
 		response.Diagnostics.AddError(
 			ErrSummaryInvalidAttr,
-			fmt.Sprintf(ErrDetailCIDRBlockInvalidIP, request.Path.Expression().String()),
+			fmt.Sprintf(ErrDetailIPv6PrefixLengthOutOfRange, prefixLength, MIN_IPV6_CIDR_PREFIX_LENGTH, MAX_IPV6_CIDR_PREFIX_LENGTH),
 		)
+	}
+}
+
+/*
+*
+
+	Custom validator for asserting each DNS server is a valid IP address
+
+*
+*/
+type DNSServerValidator struct{}
+
+func (v DNSServerValidator) Description(ctx context.Context) string {
+	return "Ensures each DNS server resolves to a valid IPv4 or IPv6 address"
+}
+func (v DNSServerValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures each DNS server resolves to a valid IPv4 or IPv6 address"
+}
+func (v DNSServerValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
 		return
 	}
+
+	if net.ParseIP(request.ConfigValue.ValueString()) == nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			ErrSummaryInvalidAttr,
+			fmt.Sprintf(ErrDetailNotValidIPWithValue, request.Path.String(), request.ConfigValue.ValueString()),
+		)
+	}
 }
 
 /*
 *
 
-	Custom validator for asserting all values in comma-delimited string are valid
+	Config validator for asserting the DHCP range, allocation pools, and default route fit the CIDR block
 
 *
 */
-type DNSServersValidator struct{}
+type AddressRangeConfigValidator struct{}
 
-func (v DNSServersValidator) Description(ctx context.Context) string {
-	return "Parses a comma-delimited string and verifies each IP address is a valid one"
+func (v AddressRangeConfigValidator) Description(ctx context.Context) string {
+	return "Ensures 'cidr_block' has a supported prefix length, and that 'dhcp_start_address', 'dhcp_end_address', every range in 'allocation_pools', and 'default_route' lie within it. Each DHCP range must not be reversed, start or end on the network, broadcast, or gateway address, or overlap another range."
 }
-func (v DNSServersValidator) MarkdownDescription(ctx context.Context) string {
-	return "Parses a comma-delimited string and verifies each IP address is a valid one"
+func (v AddressRangeConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures `cidr_block` has a supported prefix length, and that `dhcp_start_address`, `dhcp_end_address`, every range in `allocation_pools`, and `default_route` lie within it. Each DHCP range must not be reversed, start or end on the network, broadcast, or gateway address, or overlap another range."
 }
-func (v DNSServersValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
-	// Access the full configuration to check other attributes
+func (v AddressRangeConfigValidator) ValidateResource(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	var config ResourceModel
 	diags := request.Config.Get(ctx, &config)
 	response.Diagnostics.Append(diags...)
@@ -200,52 +295,341 @@ func (v DNSServersValidator) ValidateString(ctx context.Context, request validat
 		return
 	}
 
-	// Check for optional case
-	if request.ConfigValue.ValueString() == "" {
+	// Values coming from other resources can only be checked during apply. Malformed values are reported by the attribute validators
+	if config.CIDRBlock.IsNull() || config.CIDRBlock.IsUnknown() {
+		return
+	}
+	parsedIpNet, networkAddress, broadcastAddress, err := CIDRBounds(config.CIDRBlock.ValueString())
+	if err != nil {
+		return
+	}
+
+	prefixLength, _ := parsedIpNet.Mask.Size()
+	if prefixLength < MIN_CIDR_PREFIX_LENGTH || prefixLength > MAX_CIDR_PREFIX_LENGTH {
+		response.Diagnostics.AddAttributeError(
+			path.Root("cidr_block"),
+			ErrSummaryInvalidAttr,
+			fmt.Sprintf(ErrDetailCIDRPrefixLengthOutOfRange, prefixLength, MIN_CIDR_PREFIX_LENGTH, MAX_CIDR_PREFIX_LENGTH),
+		)
+		return
+	}
+	// A configured default route is the gateway of the network, otherwise the API derives it from the CIDR block
+	gateway, err := DefaultGatewayForCIDR(config.CIDRBlock.ValueString())
+	if err != nil {
 		return
 	}
+	if !config.DefaultRoute.IsNull() && !config.DefaultRoute.IsUnknown() {
+		if defaultRoute := net.ParseIP(config.DefaultRoute.ValueString()).To4(); defaultRoute != nil {
+			gateway = defaultRoute.String()
+		}
+	}
 
-	value := request.ConfigValue.ValueString()
+	// Each address must be inside the CIDR block and must not be one of its reserved addresses
+	checkAddress := func(attributePath path.Path, value types.String, reserved map[string]string) net.IP {
+		if value.IsNull() || value.IsUnknown() {
+			return nil
+		}
+		address := net.ParseIP(value.ValueString()).To4()
+		if address == nil {
+			return nil
+		}
+		if !parsedIpNet.Contains(address) {
+			response.Diagnostics.AddAttributeError(
+				attributePath,
+				ErrSummaryInvalidAttr,
+				fmt.Sprintf(ErrDetailNotInCIDRBlock, attributePath.String()),
+			)
+			return nil
+		}
+		for reservedAddress, reservedName := range reserved {
+			if address.String() == reservedAddress {
+				response.Diagnostics.AddAttributeError(
+					attributePath,
+					ErrSummaryInvalidAttr,
+					fmt.Sprintf(ErrDetailAddressIsReserved, attributePath.String(), value.ValueString(), reservedName, config.CIDRBlock.ValueString()),
+				)
+				return nil
+			}
+		}
+		return address
+	}
 
-	// Check if the value contains comma without space after it
-	if strings.Contains(value, ",") {
-		// Check for comma followed by non-space or comma followed by nothing
-		if strings.Contains(value, ", ") {
-			// Has proper ", " separator - this is valid
-		} else {
-			response.Diagnostics.AddError(
+	dhcpReserved := map[string]string{
+		networkAddress.String():   "network address",
+		broadcastAddress.String(): "broadcast address",
+		gateway:                   "gateway",
+	}
+	checkAddress(path.Root("default_route"), config.DefaultRoute, map[string]string{
+		networkAddress.String():   "network address",
+		broadcastAddress.String(): "broadcast address",
+	})
+
+	// The DHCP start and end shorthand is checked like a single allocation pool
+	type addressRange struct {
+		startPath, endPath path.Path
+		start, end         net.IP
+	}
+	checkRange := func(startPath, endPath path.Path, startValue, endValue types.String) *addressRange {
+		start := checkAddress(startPath, startValue, dhcpReserved)
+		end := checkAddress(endPath, endValue, dhcpReserved)
+		if start == nil || end == nil {
+			return nil
+		}
+		if bytes.Compare(start, end) > 0 {
+			response.Diagnostics.AddAttributeError(
+				startPath,
 				ErrSummaryInvalidAttr,
-				fmt.Sprintf(ErrDetailDNSInvalidDelimiter, request.Path.Expression().String()),
+				fmt.Sprintf(ErrDetailDHCPRangeReversed, startPath.String(), startValue.ValueString(), endPath.String(), endValue.ValueString()),
 			)
-			return
+			return nil
 		}
+		return &addressRange{startPath: startPath, endPath: endPath, start: start, end: end}
+	}
 
-		// Additional check: ensure no space before comma
-		if strings.Contains(value, " ,") {
-			response.Diagnostics.AddError(
+	checkRange(path.Root("dhcp_start_address"), path.Root("dhcp_end_address"), config.DHCPStartAddress, config.DHCPEndAddress)
+
+	if config.AllocationPools.IsNull() || config.AllocationPools.IsUnknown() {
+		return
+	}
+	var allocationPools []AllocationPoolTF
+	diags = config.AllocationPools.ElementsAs(ctx, &allocationPools, false)
+	if diags.HasError() {
+		return
+	}
+
+	var ranges []addressRange
+	for i, pool := range allocationPools {
+		poolPath := path.Root("allocation_pools").AtListIndex(i)
+		if checked := checkRange(poolPath.AtName("start"), poolPath.AtName("end"), pool.Start, pool.End); checked != nil {
+			ranges = append(ranges, *checked)
+		}
+	}
+
+	// Two ranges overlap when each one starts before the other one ends
+	for i := range ranges {
+		for j := i + 1; j < len(ranges); j++ {
+			if bytes.Compare(ranges[i].start, ranges[j].end) <= 0 && bytes.Compare(ranges[j].start, ranges[i].end) <= 0 {
+				response.Diagnostics.AddAttributeError(
+					ranges[j].startPath.ParentPath(),
+					ErrSummaryInvalidAttr,
+					fmt.Sprintf(ErrDetailAllocationPoolsOverlap, ranges[j].start, ranges[j].end, ranges[i].start, ranges[i].end),
+				)
+			}
+		}
+	}
+}
+
+/*
+*
+
+	Config validator for asserting IPv6 settings are consistent with the IPv6 CIDR block
+
+*
+*/
+type IPv6ConfigValidator struct{}
+
+func (v IPv6ConfigValidator) Description(ctx context.Context) string {
+	return "Ensures 'ipv6_cidr_block' is a /64 when 'ipv6_address_mode' is 'slaac', and that IPv6 'dns_servers' are only used on networks with an 'ipv6_cidr_block'."
+}
+func (v IPv6ConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures `ipv6_cidr_block` is a /64 when `ipv6_address_mode` is `slaac`, and that IPv6 `dns_servers` are only used on networks with an `ipv6_cidr_block`."
+}
+func (v IPv6ConfigValidator) ValidateResource(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config ResourceModel
+	diags := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// SLAAC is also the default mode, so an unset mode is checked like an explicit one
+	if !config.IPv6CIDRBlock.IsNull() && !config.IPv6CIDRBlock.IsUnknown() && !config.IPv6AddressMode.IsUnknown() {
+		_, parsedIpNet, err := net.ParseCIDR(config.IPv6CIDRBlock.ValueString())
+		isSLAAC := config.IPv6AddressMode.IsNull() || config.IPv6AddressMode.ValueString() == IPV6_ADDRESS_MODE_SLAAC
+		if err == nil && isSLAAC {
+			if prefixLength, _ := parsedIpNet.Mask.Size(); prefixLength != 64 {
+				response.Diagnostics.AddAttributeError(
+					path.Root("ipv6_cidr_block"),
+					ErrSummaryInvalidAttr,
+					fmt.Sprintf(ErrDetailSLAACRequiresPrefix64, prefixLength),
+				)
+			}
+		}
+	}
+
+	// Hosts without an IPv6 address cannot reach IPv6 DNS servers
+	if !config.IPv6CIDRBlock.IsNull() || config.DNSServers.IsNull() || config.DNSServers.IsUnknown() {
+		return
+	}
+	for i, dnsServer := range config.DNSServers.Elements() {
+		server, ok := dnsServer.(types.String)
+		if !ok || server.IsNull() || server.IsUnknown() {
+			continue
+		}
+		address := net.ParseIP(server.ValueString())
+		if address != nil && address.To4() == nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("dns_servers").AtListIndex(i),
 				ErrSummaryInvalidAttr,
-				fmt.Sprintf(ErrDetailDNSSpaceBeforeComma, request.Path.Expression().String()),
+				fmt.Sprintf(ErrDetailIPv6DNSServerWithoutIPv6, server.ValueString()),
 			)
-			return
+		}
+	}
+}
+
+/*
+*
+
+	Config validator for asserting static routes are well formed and reachable from the network
+
+*
+*/
+type RoutesConfigValidator struct{}
+
+func (v RoutesConfigValidator) Description(ctx context.Context) string {
+	return "Ensures each route in 'routes' has a valid destination CIDR block that is not the default route and not used by another route, and a next hop of the same IP version that lies within 'cidr_block' without being its network or broadcast address, or 'ipv6_cidr_block' for IPv6 routes."
+}
+func (v RoutesConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures each route in `routes` has a valid destination CIDR block that is not the default route and not used by another route, and a next hop of the same IP version that lies within `cidr_block` without being its network or broadcast address, or `ipv6_cidr_block` for IPv6 routes."
+}
+func (v RoutesConfigValidator) ValidateResource(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config ResourceModel
+	diags := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Routes.IsNull() || config.Routes.IsUnknown() {
+		return
+	}
+	if config.CIDRBlock.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("routes"),
+			ErrSummaryMissingRequiredAttr,
+			ErrDetailRoutesRequireCIDRBlock,
+		)
+		return
+	}
+
+	// Unknown or malformed CIDR blocks are reported by their own validators, so next hops are only checked against valid ones
+	parseNetworkCIDR := func(cidrBlock types.String) *net.IPNet {
+		if cidrBlock.IsNull() || cidrBlock.IsUnknown() {
+			return nil
+		}
+		_, parsedIpNet, err := net.ParseCIDR(cidrBlock.ValueString())
+		if err != nil {
+			return nil
+		}
+		return parsedIpNet
+	}
+	ipv4Network := parseNetworkCIDR(config.CIDRBlock)
+	ipv6Network := parseNetworkCIDR(config.IPv6CIDRBlock)
+	ipv4Reserved := map[string]string{}
+	if ipv4Network != nil {
+		if _, networkAddress, broadcastAddress, err := CIDRBounds(config.CIDRBlock.ValueString()); err == nil {
+			ipv4Reserved[networkAddress.String()] = "network address"
+			ipv4Reserved[broadcastAddress.String()] = "broadcast address"
 		}
 	}
 
-	ipAddresses := strings.SplitSeq(value, ", ")
-	for address := range ipAddresses {
-		address := strings.TrimSpace(address)
-		parsedIPAddress := net.ParseIP(address)
-		if parsedIPAddress == nil {
-			response.Diagnostics.AddError(
+	destinations := map[string]bool{}
+	for _, element := range config.Routes.Elements() {
+		routeObject, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+		var route RouteTF
+		diags = routeObject.As(ctx, &route, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			continue
+		}
+		// Errors are reported on the attribute of the offending route, so Terraform can point at its line
+		destinationPath := path.Root("routes").AtSetValue(element).AtName("destination")
+		nextHopPath := path.Root("routes").AtSetValue(element).AtName("next_hop")
+
+		if route.Destination.IsUnknown() || route.NextHop.IsUnknown() {
+			continue
+		}
+		destination := route.Destination.ValueString()
+		nextHop := route.NextHop.ValueString()
+
+		parsedIP, parsedDestination, err := net.ParseCIDR(destination)
+		if err != nil || !parsedIP.Equal(parsedDestination.IP) {
+			response.Diagnostics.AddAttributeError(
+				destinationPath,
+				ErrSummaryInvalidAttr,
+				fmt.Sprintf(ErrDetailRouteDestinationInvalid, destination),
+			)
+			continue
+		}
+		isIPv4Route := parsedIP.To4() != nil
+		if prefixLength, _ := parsedDestination.Mask.Size(); prefixLength == 0 {
+			response.Diagnostics.AddAttributeError(
+				destinationPath,
+				ErrSummaryInvalidAttr,
+				fmt.Sprintf(ErrDetailRouteDestinationIsDefault, destination),
+			)
+			continue
+		}
+		if destinations[parsedDestination.String()] {
+			response.Diagnostics.AddAttributeError(
+				destinationPath,
+				ErrSummaryInvalidAttr,
+				fmt.Sprintf(ErrDetailRouteDestinationDuplicate, destination),
+			)
+			continue
+		}
+		destinations[parsedDestination.String()] = true
+
+		parsedNextHop := net.ParseIP(nextHop)
+		if parsedNextHop == nil {
+			response.Diagnostics.AddAttributeError(
+				nextHopPath,
+				ErrSummaryInvalidAttr,
+				fmt.Sprintf(ErrDetailRouteNextHopInvalid, nextHop, destination),
+			)
+			continue
+		}
+		if (parsedNextHop.To4() != nil) != isIPv4Route {
+			response.Diagnostics.AddAttributeError(
+				nextHopPath,
+				ErrSummaryInvalidAttr,
+				fmt.Sprintf(ErrDetailRouteNextHopFamilyMismatch, nextHop, destination),
+			)
+			continue
+		}
+
+		// The next hop must be directly reachable, so it has to be inside the network of its IP version
+		if isIPv4Route {
+			if ipv4Network != nil && !ipv4Network.Contains(parsedNextHop) {
+				response.Diagnostics.AddAttributeError(
+					nextHopPath,
+					ErrSummaryInvalidAttr,
+					fmt.Sprintf(ErrDetailRouteNextHopNotInCIDRBlock, nextHop, destination, "cidr_block", config.CIDRBlock.ValueString()),
+				)
+			} else if reservedName, isReserved := ipv4Reserved[parsedNextHop.To4().String()]; isReserved {
+				response.Diagnostics.AddAttributeError(
+					nextHopPath,
+					ErrSummaryInvalidAttr,
+					fmt.Sprintf(ErrDetailRouteNextHopIsReserved, nextHop, destination, reservedName, config.CIDRBlock.ValueString()),
+				)
+			}
+			continue
+		}
+		if config.IPv6CIDRBlock.IsNull() {
+			response.Diagnostics.AddAttributeError(
+				destinationPath,
 				ErrSummaryInvalidAttr,
-				fmt.Sprintf(ErrDetailNotValidIPv4WithValue, request.Path.Expression().String(), address),
+				fmt.Sprintf(ErrDetailRouteNextHopRequiresIPv6, destination),
 			)
 			continue
 		}
-		parsedIPAddressv4 := parsedIPAddress.To4()
-		if parsedIPAddressv4 == nil {
-			response.Diagnostics.AddError(
+		if ipv6Network != nil && !ipv6Network.Contains(parsedNextHop) {
+			response.Diagnostics.AddAttributeError(
+				nextHopPath,
 				ErrSummaryInvalidAttr,
-				fmt.Sprintf(ErrDetailNotValidIPv4WithValue, request.Path.Expression().String(), address),
+				fmt.Sprintf(ErrDetailRouteNextHopNotInCIDRBlock, nextHop, destination, "ipv6_cidr_block", config.IPv6CIDRBlock.ValueString()),
 			)
 		}
 	}
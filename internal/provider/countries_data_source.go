@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-gpcn/internal/datacenters"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &countriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &countriesDataSource{}
+)
+
+func NewCountriesDataSource() datasource.DataSource {
+	return &countriesDataSource{}
+}
+
+type countriesDataSource struct {
+	client *http.Client
+}
+
+type countriesDataSourceModel struct {
+	Countries types.List `tfsdk:"countries"`
+}
+
+type countryDataResponseTF struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Abbreviation types.String `tfsdk:"abbreviation"`
+}
+
+func (o countryDataResponseTF) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":           types.Int64Type,
+		"name":         types.StringType,
+		"abbreviation": types.StringType,
+	}
+}
+
+func (d *countriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_countries"
+}
+
+func (d *countriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the countries that contain GPCN datacenters. Use this data source to list the countries available for deployment.",
+		Attributes: map[string]schema.Attribute{
+			"countries": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of countries that contain datacenters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Numeric identifier of the country.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the country. Use this value for the 'country_name' filter of gpcn_datacenters and gpcn_regions.",
+						},
+						"abbreviation": schema.StringAttribute{
+							Computed:    true,
+							Description: "Two-letter country code abbreviation (e.g., 'US').",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *countriesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *countriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, datacenters.LogStartingReadGPCNCountriesDataSource)
+	var state countriesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datacenterCountryResponse, err := datacenters.GetAllCountries(d.client, ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			datacenters.ErrSummaryUnableGetCountries,
+			err.Error(),
+		)
+		return
+	}
+
+	// The countries endpoint only returns IDs and names, so the abbreviations are taken from the regions of each country
+	datacenterRegionResponse, err := datacenters.GetCountriesAndRegions(d.client, ctx, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			datacenters.ErrSummaryUnableGetCountries,
+			err.Error(),
+		)
+		return
+	}
+	abbreviations := map[int64]string{}
+	for _, region := range datacenterRegionResponse {
+		abbreviations[region.CountryID] = region.CountryAbbreviation
+	}
+
+	countries := []countryDataResponseTF{}
+	for _, country := range datacenterCountryResponse {
+		countries = append(countries, countryDataResponseTF{
+			ID:           types.Int64Value(country.ID),
+			Name:         types.StringValue(country.Name),
+			Abbreviation: types.StringValue(abbreviations[country.ID]),
+		})
+	}
+
+	state.Countries, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: countryDataResponseTF{}.AttrTypes()}, countries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, datacenters.LogSuccessfullyFinishedReadGPCNCountriesDataSource)
+}
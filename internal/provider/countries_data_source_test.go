@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestCountriesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The country of a known datacenter is listed with its abbreviation
+			{
+				Config: providerConfig + `
+data "gpcn_datacenter" "test" {
+  id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+}
+
+data "gpcn_countries" "all" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair("data.gpcn_countries.all", "countries.*.name", "data.gpcn_datacenter.test", "country_name"),
+					resource.TestCheckTypeSetElemAttrPair("data.gpcn_countries.all", "countries.*.abbreviation", "data.gpcn_datacenter.test", "country_abbreviation"),
+					testCheckEveryListElementAttr("data.gpcn_countries.all", "countries", "abbreviation", testNotEquals("")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"terraform-provider-gpcn/internal/datacenters"
+	"terraform-provider-gpcn/internal/networks"
+	"terraform-provider-gpcn/internal/virtualmachines"
+	"terraform-provider-gpcn/internal/volumes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &datacenterCapabilitiesDataSource{}
+	_ datasource.DataSourceWithConfigure = &datacenterCapabilitiesDataSource{}
+)
+
+func NewDatacenterCapabilitiesDataSource() datasource.DataSource {
+	return &datacenterCapabilitiesDataSource{}
+}
+
+type datacenterCapabilitiesDataSource struct {
+	client *http.Client
+}
+
+type datacenterCapabilitiesDataSourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	RegionID            types.Int64  `tfsdk:"region_id"`
+	RegionName          types.String `tfsdk:"region_name"`
+	CountryID           types.Int64  `tfsdk:"country_id"`
+	CountryName         types.String `tfsdk:"country_name"`
+	CountryAbbreviation types.String `tfsdk:"country_abbreviation"`
+	Images              types.List   `tfsdk:"images"`
+	VolumeTypes         types.List   `tfsdk:"volume_types"`
+	NetworkTypes        types.List   `tfsdk:"network_types"`
+}
+
+type datacenterImageCapabilityTF struct {
+	ID    types.Int64  `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Sizes types.List   `tfsdk:"sizes"`
+}
+
+func (o datacenterImageCapabilityTF) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":    types.Int64Type,
+		"name":  types.StringType,
+		"sizes": types.ListType{ElemType: types.ObjectType{AttrTypes: virtualmachines.VirtualMachineSizesDataResponseTF{}.AttrTypes()}},
+	}
+}
+
+func (d *datacenterCapabilitiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_datacenter"
+}
+
+func (d *datacenterCapabilitiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves a single GPCN datacenter, looked up by ID or by name, together with its capabilities: the virtual machine images and sizes, volume types, and network types it supports. Use this data source to check that a datacenter supports a configuration before deploying to it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Unique identifier of the datacenter to look up. Exactly one of 'id' or 'name' must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the datacenter to look up. Case-insensitive. Exactly one of 'id' or 'name' must be set.",
+			},
+			"region_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Numeric identifier of the region where the datacenter is located.",
+			},
+			"region_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the region where the datacenter is located.",
+			},
+			"country_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Numeric identifier of the country where the datacenter is located.",
+			},
+			"country_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the country where the datacenter is located.",
+			},
+			"country_abbreviation": schema.StringAttribute{
+				Computed:    true,
+				Description: "Two-letter country code abbreviation (e.g., 'US').",
+			},
+			"images": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Virtual machine images available in the datacenter, each with the sizes it can be deployed with.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Unique identifier for the image.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the image. Use this value for the 'image' attribute of gpcn_virtualmachine.",
+						},
+						"sizes": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Sizes available for the image, ordered from smallest to largest.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.Int64Attribute{
+										Computed:    true,
+										Description: "Unique identifier for the size configuration.",
+									},
+									"name": schema.StringAttribute{
+										Computed:    true,
+										Description: "Name of the size configuration. Use this value for the 'size' attribute of gpcn_virtualmachine.",
+									},
+									"cpu": schema.Int64Attribute{
+										Computed:    true,
+										Description: "Number of CPU cores.",
+									},
+									"ram": schema.Int64Attribute{
+										Computed:    true,
+										Description: "Amount of RAM in MB.",
+									},
+									"disk": schema.Int64Attribute{
+										Computed:    true,
+										Description: "Disk size in GB.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"volume_types": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Volume types available in the datacenter.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Unique identifier for the volume type.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the volume type. Use this value for the 'volume_type' attribute of gpcn_volume.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Additional information about the volume type.",
+						},
+						"available_sizes_gb": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.Int64Type,
+							Description: "Sizes in GB that volumes of this type can be provisioned with.",
+						},
+					},
+				},
+			},
+			"network_types": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Network types that can be created in the datacenter. Use one of these values for the 'network_type' attribute of gpcn_network.",
+			},
+		},
+	}
+}
+
+func (d *datacenterCapabilitiesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *datacenterCapabilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, datacenters.LogStartingReadGPCNDatacenterDataSource)
+	var state datacenterCapabilitiesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datacenter, err := datacenters.FindDatacenter(d.client, ctx, state.ID.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			datacenters.ErrSummaryUnableGetDatacenter,
+			err.Error(),
+		)
+		return
+	}
+	state.ID = types.StringValue(datacenter.ID)
+	state.Name = types.StringValue(datacenter.Name)
+	state.RegionID = types.Int64Value(datacenter.RegionID)
+	state.RegionName = types.StringValue(datacenter.RegionName)
+	state.CountryID = types.Int64Value(datacenter.CountryID)
+	state.CountryName = types.StringValue(datacenter.CountryName)
+	state.CountryAbbreviation = types.StringValue(datacenter.CountryAbbreviation)
+
+	tflog.Info(ctx, fmt.Sprintf(datacenters.LogCollectingDatacenterCapabilities, datacenter.ID))
+	// Sizes are offered per image, so every image needs its own lookup
+	imageList, err := virtualmachines.ListVirtualMachineImages(d.client, ctx, datacenter.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			datacenters.ErrSummaryUnableGetDatacenterCapabilities,
+			fmt.Sprintf(datacenters.ErrDetailUnableToListImagesForDatacenter, datacenter.ID)+": "+err.Error(),
+		)
+		return
+	}
+
+	images := []datacenterImageCapabilityTF{}
+	for _, image := range imageList {
+		sizes, err := virtualmachines.ListVirtualMachineSizes(d.client, ctx, image.ID.ValueInt64(), datacenter.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				datacenters.ErrSummaryUnableGetDatacenterCapabilities,
+				fmt.Sprintf(datacenters.ErrDetailUnableToListSizesForImage, image.Name.ValueString(), datacenter.ID)+": "+err.Error(),
+			)
+			return
+		}
+		slices.SortStableFunc(sizes, virtualmachines.CompareVirtualMachineSizes)
+
+		sizesList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: virtualmachines.VirtualMachineSizesDataResponseTF{}.AttrTypes()}, sizes)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		images = append(images, datacenterImageCapabilityTF{
+			ID:    image.ID,
+			Name:  image.Name,
+			Sizes: sizesList,
+		})
+	}
+
+	volumeTypeList, err := volumes.ListVolumeTypes(d.client, ctx, datacenter.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			datacenters.ErrSummaryUnableGetDatacenterCapabilities,
+			fmt.Sprintf(volumes.ErrDetailUnableToListVolumeTypesWithDatacenterID, datacenter.ID)+": "+err.Error(),
+		)
+		return
+	}
+
+	volumeTypes := []volumes.VolumeTypeDataResponseTF{}
+	for _, volumeType := range volumeTypeList {
+		volumeTypes = append(volumeTypes, volumes.MapVolumeTypeDataToTF(ctx, volumeType))
+	}
+
+	state.Images, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datacenterImageCapabilityTF{}.AttrTypes()}, images)
+	resp.Diagnostics.Append(diags...)
+	state.VolumeTypes, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: volumes.VolumeTypeDataResponseTF{}.AttrTypes()}, volumeTypes)
+	resp.Diagnostics.Append(diags...)
+	// The API has no per-datacenter network catalog; every datacenter supports both network types
+	state.NetworkTypes, diags = types.ListValueFrom(ctx, types.StringType, []string{networks.NETWORK_TYPE_STANDARD, networks.NETWORK_TYPE_CUSTOM})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, datacenters.LogSuccessfullyFinishedReadGPCNDatacenterDataSource)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDatacenterDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Look the same datacenter up by ID and by name, and read its capabilities
+			{
+				Config: providerConfig + `
+data "gpcn_datacenter" "by_id" {
+  id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+}
+
+data "gpcn_datacenter" "by_name" {
+  name = data.gpcn_datacenter.by_id.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.gpcn_datacenter.by_id", "name"),
+					resource.TestCheckResourceAttrSet("data.gpcn_datacenter.by_id", "region_name"),
+					resource.TestCheckResourceAttrSet("data.gpcn_datacenter.by_id", "country_name"),
+					resource.TestCheckResourceAttr("data.gpcn_datacenter.by_name", "id", "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"),
+					// The catalog used by the resources is part of the capabilities
+					resource.TestCheckTypeSetElemNestedAttrs("data.gpcn_datacenter.by_id", "images.*", map[string]string{"name": "Alma Linux 8.x"}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gpcn_datacenter.by_id", "images.*.sizes.*", map[string]string{"name": "Micro"}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gpcn_datacenter.by_id", "volume_types.*", map[string]string{"name": "SSD"}),
+					resource.TestCheckTypeSetElemAttr("data.gpcn_datacenter.by_id", "network_types.*", "standard"),
+				),
+			},
+			// A name that matches no datacenter fails
+			{
+				Config: providerConfig + `
+data "gpcn_datacenter" "missing" {
+  name = "tfacc-no-such-datacenter"
+}
+`,
+				ExpectError: regexp.MustCompile("Unable to get GPCN Datacenter"),
+			},
+		},
+	})
+}
@@ -2,9 +2,7 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -31,20 +29,6 @@ type datacenterDataSourceModel struct {
 	DataCenters types.List   `tfsdk:"datacenters"`
 }
 
-type datacenterResponse struct {
-	Success bool                     `json:"success"`
-	Message string                   `json:"message"`
-	Data    []datacenterDataResponse `json:"data"`
-}
-type datacenterDataResponse struct {
-	ID                  string `json:"id"`
-	Name                string `json:"name"`
-	RegionID            int64  `json:"regionId"`
-	RegionName          string `json:"regionName"`
-	CountryID           int64  `json:"countryId"`
-	CountryName         string `json:"countryName"`
-	CountryAbbreviation string `json:"countryAbbreviation"`
-}
 type datacenterDataResponseTF struct {
 	ID                  types.String `tfsdk:"id"`
 	Name                types.String `tfsdk:"name"`
@@ -55,29 +39,6 @@ type datacenterDataResponseTF struct {
 	CountryAbbreviation types.String `tfsdk:"country_abbreviation"`
 }
 
-type datacenterRegionResponse struct {
-	Success bool                           `json:"success"`
-	Message string                         `json:"message"`
-	Data    []datacenterRegionDataResponse `json:"data"`
-}
-type datacenterRegionDataResponse struct {
-	ID                  int64  `json:"id"`
-	Name                string `json:"name"`
-	CountryID           int64  `json:"countryId"`
-	CountryName         string `json:"countryName"`
-	CountryAbbreviation string `json:"countryAbbreviation"`
-}
-
-type datacenterCountryResponse struct {
-	Success bool                            `json:"success"`
-	Message string                          `json:"message"`
-	Data    []datacenterCountryDataResponse `json:"data"`
-}
-type datacenterCountryDataResponse struct {
-	ID   int64  `json:"id"`
-	Name string `json:"name"`
-}
-
 func (o datacenterDataResponseTF) AttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
 		"id":                   types.StringType,
@@ -187,7 +148,7 @@ func (d *datacenterDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		additionalQueryString += "&name=" + url.QueryEscape(state.Name.ValueString())
 	}
 
-	datacenterResponse, err := d.getDatacenters(additionalQueryString)
+	matchingDatacenters, err := datacenters.GetDatacenters(d.client, ctx, additionalQueryString)
 	if err != nil {
 		// Big failure, no helpful error message
 		resp.Diagnostics.AddError(
@@ -198,8 +159,8 @@ func (d *datacenterDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	// If no data centers found, search with just country name to make a friendly error message
-	if len(datacenterResponse.Data) < 1 {
-		datacenterRegionResponse, err := d.getCountriesAndRegions(url.QueryEscape(state.CountryName.ValueString()))
+	if len(matchingDatacenters) < 1 {
+		datacenterRegionResponse, err := datacenters.GetCountriesAndRegions(d.client, ctx, url.QueryEscape(state.CountryName.ValueString()))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				datacenters.ErrSummaryUnableGetDatacenters,
@@ -208,9 +169,9 @@ func (d *datacenterDataSource) Read(ctx context.Context, req datasource.ReadRequ
 			return
 		}
 
-		if len(datacenterRegionResponse.Data) > 0 {
+		if len(datacenterRegionResponse) > 0 {
 			var countryAndRegion []string
-			for _, region := range datacenterRegionResponse.Data {
+			for _, region := range datacenterRegionResponse {
 				countryAndRegion = append(countryAndRegion, region.CountryName+" - "+region.Name)
 			}
 			countryAndRegionFormatted := strings.Join(countryAndRegion, ", ")
@@ -219,7 +180,7 @@ func (d *datacenterDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		}
 
 		// If no data centers found still, search with nothing and return first 10
-		datacenterCountryResponse, err := d.getAllCountries()
+		datacenterCountryResponse, err := datacenters.GetAllCountries(d.client, ctx)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				datacenters.ErrSummaryUnableGetDatacenters,
@@ -228,7 +189,7 @@ func (d *datacenterDataSource) Read(ctx context.Context, req datasource.ReadRequ
 			return
 		}
 		var countries []string
-		for _, country := range datacenterCountryResponse.Data {
+		for _, country := range datacenterCountryResponse {
 			countries = append(countries, country.Name)
 		}
 		countryAndRegionFormatted := strings.Join(countries, ", ")
@@ -237,7 +198,7 @@ func (d *datacenterDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	var datacenters []datacenterDataResponseTF
-	for _, datacenter := range datacenterResponse.Data {
+	for _, datacenter := range matchingDatacenters {
 		datacenters = append(datacenters, datacenterDataResponseTF{
 			ID:                  types.StringValue(datacenter.ID),
 			Name:                types.StringValue(datacenter.Name),
@@ -258,89 +219,3 @@ func (d *datacenterDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 }
-
-func (d *datacenterDataSource) getDatacenters(queryString string) (*datacenterResponse, error) {
-	datacenterUrl := datacenters.BASE_URL_V1 + "?page=1&limit=100"
-	request, err := http.NewRequest("GET", datacenterUrl+queryString, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := d.client.Do(request)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
-
-	// Read the response body and process it as datacenterResponse
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	var datacenterResponse datacenterResponse
-	err = json.Unmarshal(body, &datacenterResponse)
-
-	if err != nil {
-		return nil, err
-	}
-	return &datacenterResponse, nil
-}
-
-func (d *datacenterDataSource) getCountriesAndRegions(countryName string) (*datacenterRegionResponse, error) {
-	// Safe to use since it'll default to empty string if not provided, which will just search all countries
-	datacenterUrl := datacenters.BASE_URL_V1 + "regions?page=1&limit=100&countryName=" + countryName
-	request, err := http.NewRequest("GET", datacenterUrl, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := d.client.Do(request)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
-
-	// Read the response body and process it as datacenterRegionResponse
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	var datacenterRegionResponse datacenterRegionResponse
-	err = json.Unmarshal(body, &datacenterRegionResponse)
-
-	if err != nil {
-		return nil, err
-	}
-	return &datacenterRegionResponse, nil
-}
-
-func (d *datacenterDataSource) getAllCountries() (*datacenterCountryResponse, error) {
-	// Safe to use since it'll default to empty string if not provided, which will just search all countries
-	datacenterUrl := datacenters.BASE_URL_V1 + "countries?page=1&limit=100"
-	request, err := http.NewRequest("GET", datacenterUrl, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := d.client.Do(request)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
-
-	// Read the response body and process it as datacenterCountryResponse
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	var datacenterCountryResponse datacenterCountryResponse
-	err = json.Unmarshal(body, &datacenterCountryResponse)
-
-	if err != nil {
-		return nil, err
-	}
-	return &datacenterCountryResponse, nil
-}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"terraform-provider-gpcn/internal/virtualmachines"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &imagesDataSource{}
+	_ datasource.DataSourceWithConfigure = &imagesDataSource{}
+)
+
+func NewImagesDataSource() datasource.DataSource {
+	return &imagesDataSource{}
+}
+
+type imagesDataSource struct {
+	client *http.Client
+}
+
+type imagesDataSourceModel struct {
+	DatacenterId types.String `tfsdk:"datacenter_id"`
+	NameRegex    types.String `tfsdk:"name_regex"`
+	MostRecent   types.Bool   `tfsdk:"most_recent"`
+	Images       types.List   `tfsdk:"images"`
+}
+
+func (d *imagesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_images"
+}
+
+func (d *imagesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the operating system images available for virtual machines in a GPCN datacenter. Use this data source to select an image for gpcn_virtualmachine without hard-coding its name.",
+		Attributes: map[string]schema.Attribute{
+			"datacenter_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Unique identifier of the datacenter to list images for.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter images whose name matches this regular expression (e.g., '^Ubuntu').",
+			},
+			"most_recent": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, only the most recently added matching image (the one with the highest ID) is returned, and the lookup fails when no image matches.",
+			},
+			"images": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of images matching the specified filter criteria.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Unique identifier for the image.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the image. Use this value for the 'image' attribute of gpcn_virtualmachine.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *imagesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			virtualmachines.ErrSummaryUnexpectedConfigureType,
+			fmt.Sprintf(virtualmachines.ErrDetailExpectedHTTPClient, req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *imagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, virtualmachines.LogStartingReadGPCNImagesDataSource)
+	var state imagesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		compiled, err := regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				virtualmachines.ErrSummaryInvalidFilter,
+				fmt.Sprintf(virtualmachines.ErrDetailInvalidNameRegex, state.NameRegex.ValueString(), err.Error()),
+			)
+			return
+		}
+		nameRegex = compiled
+	}
+
+	allImages, err := virtualmachines.ListVirtualMachineImages(d.client, ctx, state.DatacenterId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			virtualmachines.ErrSummaryUnableToListImages,
+			err.Error(),
+		)
+		return
+	}
+
+	images := []virtualmachines.VirtualMachineImagesDataResponseTF{}
+	for _, image := range allImages {
+		if nameRegex != nil && !nameRegex.MatchString(image.Name.ValueString()) {
+			continue
+		}
+		images = append(images, image)
+	}
+
+	if state.MostRecent.ValueBool() {
+		if len(images) < 1 {
+			var names []string
+			for _, image := range allImages {
+				names = append(names, image.Name.ValueString())
+			}
+			resp.Diagnostics.AddError(
+				virtualmachines.ErrSummaryNoMatchingImage,
+				fmt.Sprintf(virtualmachines.ErrDetailNoImageMatchesFilters, state.DatacenterId.ValueString(), strings.Join(names, ", ")),
+			)
+			return
+		}
+
+		// The catalog does not expose creation dates, so newer images are identified by their higher ID
+		mostRecent := images[0]
+		for _, image := range images {
+			if image.ID.ValueInt64() > mostRecent.ID.ValueInt64() {
+				mostRecent = image
+			}
+		}
+		images = []virtualmachines.VirtualMachineImagesDataResponseTF{mostRecent}
+	}
+
+	state.Images, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: virtualmachines.VirtualMachineImagesDataResponseTF{}.AttrTypes()}, images)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, virtualmachines.LogSuccessfullyFinishedReadGPCNImagesDataSource)
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestImagesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The name regex narrows the catalog down, and most_recent down to a single image
+			{
+				Config: providerConfig + `
+data "gpcn_images" "all" {
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+}
+
+data "gpcn_images" "alma" {
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  name_regex    = "^Alma"
+}
+
+data "gpcn_images" "alma_latest" {
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  name_regex    = "^Alma"
+  most_recent   = true
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.gpcn_images.alma_latest", tfjsonpath.New("images"), knownvalue.ListSizeExact(1)),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.gpcn_images.all", "images.*", map[string]string{"name": "Alma Linux 8.x"}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gpcn_images.alma", "images.*", map[string]string{"name": "Alma Linux 8.x"}),
+					testCheckEveryListElementAttr("data.gpcn_images.alma", "images", "name", testMatches("^Alma")),
+					testCheckEveryListElementAttr("data.gpcn_images.alma_latest", "images", "name", testMatches("^Alma")),
+				),
+			},
+			// most_recent fails when nothing matches, instead of returning no image
+			{
+				Config: providerConfig + `
+data "gpcn_images" "missing" {
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  name_regex    = "^tfacc-no-such-image$"
+  most_recent   = true
+}
+`,
+				ExpectError: regexp.MustCompile("No matching GPCN Virtual Machine image"),
+			},
+			// An invalid regular expression is reported as such
+			{
+				Config: providerConfig + `
+data "gpcn_images" "invalid" {
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  name_regex    = "^Alma("
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid filter"),
+			},
+		},
+	})
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-gpcn/internal/datacenters"
+	"terraform-provider-gpcn/internal/helpers"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Identity shared by every GPCN resource
+type resourceIdentityModel struct {
+	ID           types.String `tfsdk:"id"`
+	DatacenterId types.String `tfsdk:"datacenter_id"`
+}
+
+// Identity schema shared by every GPCN resource. Resources are addressed by their ID, and the datacenter is recorded
+// alongside it so identities returned by list resources can be told apart without reading each resource
+func resourceIdentitySchema(description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       description,
+				RequiredForImport: true,
+			},
+			"datacenter_id": identityschema.StringAttribute{
+				Description:       "Unique identifier of the datacenter the resource is deployed in. Optional when importing, it is read from the API",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+// Helper function to record the identity of a resource after Create, Read, and Update. Clients that do not support identity
+// (Terraform versions before 1.12) do not send one, in which case there is nothing to set
+func setResourceIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id, datacenterId types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, resourceIdentityModel{ID: id, DatacenterId: datacenterId})
+}
+
+// Helper function shared by every ImportState. Accepts the resource ID, "name:<name>", "<datacenter_name>/<name>" (or its
+// "dc:" alias), or an identity from an import block. Names are resolved with getByName, which receives an empty datacenter ID for the "name:" form
+// and returns the ID and datacenter ID of the matching resource
+func importStateByIdOrName(ctx context.Context, httpClient *http.Client, req resource.ImportStateRequest, resp *resource.ImportStateResponse, errSummary string, getByName func(datacenterId, name string) (string, string, error)) {
+	datacenterName, name, isName, err := helpers.ParseNameImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			errSummary,
+			err.Error(),
+		)
+		return
+	}
+	if !isName {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+
+	var datacenterId string
+	if datacenterName != "" {
+		datacenter, err := datacenters.GetDatacenterByName(httpClient, ctx, datacenterName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				errSummary,
+				err.Error(),
+			)
+			return
+		}
+		datacenterId = datacenter.ID
+	}
+
+	id, resourceDatacenterId, err := getByName(datacenterId, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			errSummary,
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, types.StringValue(id), types.StringValue(resourceDatacenterId))...)
+}
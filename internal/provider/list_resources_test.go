@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"terraform-provider-gpcn/internal/client"
+
+	fwlist "github.com/hashicorp/terraform-plugin-framework/list"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestListResourcesQuery(t *testing.T) {
+	resourceConfig := `
+resource "gpcn_network" "list" {
+  name          = "tfacc-list-network"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_virtualmachine" "list" {
+  name          = "tfacc-list-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  size          = "Micro"
+  image         = "Alma Linux 8.x"
+  allocate_public_ip = false
+  network_ids   = [gpcn_network.list.id]
+}
+
+resource "gpcn_volume" "list" {
+  name          = "tfacc-list-volume"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+`
+	var networkId, virtualMachineId, volumeId string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCaptureResourceAttr("gpcn_network.list", "id", &networkId),
+					testCaptureResourceAttr("gpcn_virtualmachine.list", "id", &virtualMachineId),
+					testCaptureResourceAttr("gpcn_volume.list", "id", &volumeId),
+				),
+			},
+			// Each list block finds the resources matching its filters, and nothing in a datacenter without resources
+			{
+				Query: true,
+				Config: providerConfig + `
+list "gpcn_network" "standard" {
+  provider = gpcn
+
+  config {
+    datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+    network_type  = "standard"
+  }
+}
+
+list "gpcn_network" "custom" {
+  provider = gpcn
+
+  config {
+    datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+    network_type  = "custom"
+  }
+}
+
+list "gpcn_network" "missing_datacenter" {
+  provider = gpcn
+
+  config {
+    datacenter_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
+
+list "gpcn_virtualmachine" "by_name" {
+  provider = gpcn
+
+  config {
+    name_regex = "^tfacc-list-vm$"
+  }
+}
+
+list "gpcn_volume" "unattached" {
+  provider = gpcn
+
+  config {
+    datacenter_id    = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+    attachment_state = "unattached"
+  }
+}
+
+list "gpcn_volume" "attached" {
+  provider = gpcn
+
+  config {
+    attachment_state = "attached"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectResourceDisplayName("gpcn_network.standard", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"id":            testKnownValueCaptured(&networkId),
+						"datacenter_id": knownvalue.StringExact("1ea6b709-0671-46fa-aea8-bdc8eb897d3d"),
+					}), knownvalue.StringExact("tfacc-list-network")),
+					querycheck.ExpectNoIdentity("gpcn_network.custom", map[string]knownvalue.Check{
+						"id":            testKnownValueCaptured(&networkId),
+						"datacenter_id": knownvalue.StringExact("1ea6b709-0671-46fa-aea8-bdc8eb897d3d"),
+					}),
+					querycheck.ExpectLength("gpcn_network.missing_datacenter", 0),
+					querycheck.ExpectLength("gpcn_virtualmachine.by_name", 1),
+					querycheck.ExpectIdentity("gpcn_virtualmachine.by_name", map[string]knownvalue.Check{
+						"id":            testKnownValueCaptured(&virtualMachineId),
+						"datacenter_id": knownvalue.StringExact("1ea6b709-0671-46fa-aea8-bdc8eb897d3d"),
+					}),
+					querycheck.ExpectIdentity("gpcn_volume.unattached", map[string]knownvalue.Check{
+						"id":            testKnownValueCaptured(&volumeId),
+						"datacenter_id": knownvalue.StringExact("1ea6b709-0671-46fa-aea8-bdc8eb897d3d"),
+					}),
+					querycheck.ExpectNoIdentity("gpcn_volume.attached", map[string]knownvalue.Check{
+						"id":            testKnownValueCaptured(&volumeId),
+						"datacenter_id": knownvalue.StringExact("1ea6b709-0671-46fa-aea8-bdc8eb897d3d"),
+					}),
+				},
+			},
+		},
+	})
+}
+
+// A failing API must fail the query instead of returning an empty list, which would look like there is nothing to import
+func TestListResourcesSurfaceErrors(t *testing.T) {
+	listResources := map[string]func() fwlist.ListResource{
+		"gpcn_network":        NewNetworksListResource,
+		"gpcn_virtualmachine": NewVirtualMachinesListResource,
+		"gpcn_volume":         NewVolumesListResource,
+	}
+	responses := map[string]struct {
+		handler       http.HandlerFunc
+		expectedError string
+	}{
+		"unsuccessful response": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"success":false,"message":"invalid api key","data":[]}`)
+			},
+			expectedError: "invalid api key",
+		},
+		"server error": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			expectedError: "500",
+		},
+	}
+
+	for typeName, newListResource := range listResources {
+		for name, response := range responses {
+			t.Run(typeName+"/"+name, func(t *testing.T) {
+				server := httptest.NewServer(response.handler)
+				defer server.Close()
+				httpClient, _ := client.NewHttpClient(server.URL, "key")
+
+				listResource := newListResource()
+				listResource.(fwlist.ListResourceWithConfigure).Configure(context.Background(), fwresource.ConfigureRequest{ProviderData: httpClient}, &fwresource.ConfigureResponse{})
+
+				stream := &fwlist.ListResultsStream{}
+				listResource.List(context.Background(), testEmptyListRequest(t, listResource), stream)
+
+				var results []fwlist.ListResult
+				for result := range stream.Results {
+					results = append(results, result)
+				}
+				if len(results) != 1 || !results[0].Diagnostics.HasError() {
+					t.Fatalf("expected a single result with an error, got %d results", len(results))
+				}
+				detail := results[0].Diagnostics.Errors()[0].Detail()
+				if !strings.Contains(detail, response.expectedError) {
+					t.Fatalf("expected an error containing %q, got: %s", response.expectedError, detail)
+				}
+			})
+		}
+	}
+}
+
+// Checks a value that is only known once an earlier step has captured it
+func testKnownValueCaptured(value *string) knownvalue.Check {
+	return knownvalue.StringFunc(func(actual string) error {
+		if actual != *value {
+			return fmt.Errorf("expected %q, got %q", *value, actual)
+		}
+		return nil
+	})
+}
+
+// Builds a list request without any filters set
+func testEmptyListRequest(t *testing.T, listResource fwlist.ListResource) fwlist.ListRequest {
+	ctx := context.Background()
+	schemaResp := &fwlist.ListResourceSchemaResponse{}
+	listResource.ListResourceConfigSchema(ctx, fwlist.ListResourceSchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema error: %v", schemaResp.Diagnostics)
+	}
+
+	values := map[string]tftypes.Value{}
+	for name, attribute := range schemaResp.Schema.GetAttributes() {
+		values[name] = tftypes.NewValue(attribute.GetType().TerraformType(ctx), nil)
+	}
+	return fwlist.ListRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), values),
+		},
+	}
+}
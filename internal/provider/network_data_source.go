@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-gpcn/internal/networks"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &networkDataSource{}
+	_ datasource.DataSourceWithConfigure = &networkDataSource{}
+)
+
+func NewNetworkDataSource() datasource.DataSource {
+	return &networkDataSource{}
+}
+
+type networkDataSource struct {
+	client *http.Client
+}
+
+func (d *networkDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network"
+}
+
+func (d *networkDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := networkDataSourceAttributes()
+	// The lookup keys are configurable, everything else is read from the API
+	attributes["id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Unique identifier of the network to look up. Exactly one of 'id' or 'name' must be set.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Name of the network to look up. Must be specified together with 'datacenter_id'. Exactly one of 'id' or 'name' must be set.",
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("datacenter_id")),
+		},
+	}
+	attributes["datacenter_id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Unique identifier of the datacenter to search when looking the network up by 'name'.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Retrieves information about a single existing GPCN network, looked up by ID or by name within a datacenter. Use this data source to consume networks managed outside of this configuration.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *networkDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *networkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, networks.LogStartingReadGPCNNetworkDataSource)
+	var config networks.NetworkDataResponseTF
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state networks.NetworkDataResponseTF
+	if !config.ID.IsNull() {
+		getNetworkResponse, err := networks.GetNetwork(d.client, ctx, config.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				networks.ErrSummaryUnableToGetNetwork,
+				fmt.Sprintf(networks.ErrDetailUnableToGetNetworkWithID, config.ID.ValueString())+": "+err.Error(),
+			)
+			return
+		}
+		state = networks.MapNetworkDataToTF(ctx, getNetworkResponse.Data)
+	} else {
+		network, err := networks.GetNetworkByName(d.client, ctx, config.DatacenterId.ValueString(), config.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				networks.ErrSummaryUnableToGetNetwork,
+				err.Error(),
+			)
+			return
+		}
+		state = networks.MapNetworkDataToTF(ctx, *network)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, networks.LogSuccessfullyFinishedReadGPCNNetworkDataSource)
+}
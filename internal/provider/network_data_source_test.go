@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestNetworkDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Look the same network up by name and by ID
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+  name          = "tfacc-ds-network"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+data "gpcn_network" "by_name" {
+  name          = gpcn_network.test.name
+  datacenter_id = gpcn_network.test.datacenter_id
+}
+
+data "gpcn_network" "by_id" {
+  id = gpcn_network.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.gpcn_network.by_name", "id", "gpcn_network.test", "id"),
+					resource.TestCheckResourceAttr("data.gpcn_network.by_name", "cidr_block", "10.0.0.0/24"),
+					resource.TestCheckResourceAttr("data.gpcn_network.by_name", "network_type", "standard"),
+					resource.TestCheckResourceAttr("data.gpcn_network.by_id", "name", "tfacc-ds-network"),
+					resource.TestCheckResourceAttr("data.gpcn_network.by_id", "dns_servers.#", "2"),
+				),
+			},
+			// A name that matches no network fails instead of returning an empty network
+			{
+				Config: providerConfig + `
+data "gpcn_network" "missing" {
+  name          = "tfacc-ds-network-does-not-exist"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+}
+`,
+				ExpectError: regexp.MustCompile("Unable to get GPCN Network"),
+			},
+		},
+	})
+}
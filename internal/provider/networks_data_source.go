@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-gpcn/internal/networks"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &networksDataSource{}
+	_ datasource.DataSourceWithConfigure = &networksDataSource{}
+)
+
+func NewNetworksDataSource() datasource.DataSource {
+	return &networksDataSource{}
+}
+
+type networksDataSource struct {
+	client *http.Client
+}
+
+type networksDataSourceModel struct {
+	DatacenterId types.String `tfsdk:"datacenter_id"`
+	NetworkType  types.String `tfsdk:"network_type"`
+	Networks     types.List   `tfsdk:"networks"`
+}
+
+func (d *networksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks"
+}
+
+func (d *networksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves information about existing GPCN networks. Use this data source to find networks by datacenter or network type, including ones not managed by this configuration.",
+		Attributes: map[string]schema.Attribute{
+			"datacenter_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter networks by the unique identifier of their datacenter.",
+			},
+			"network_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter networks by type: either 'standard' or 'custom'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(networks.NETWORK_TYPE_STANDARD, networks.NETWORK_TYPE_CUSTOM),
+				},
+			},
+			"networks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of networks matching the specified filter criteria.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: networkDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Attributes describing a single network. Shared between the gpcn_network and gpcn_networks data sources
+func networkDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Unique identifier for the network in UUID format.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Human-readable name of the network.",
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: "Additional information about the network.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timestamp when the network was created in ISO-8601 format.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timestamp when the network was last updated in ISO-8601 format.",
+		},
+		"snat": schema.StringAttribute{
+			Computed:    true,
+			Description: "Source Network Address Translation (SNAT) status.",
+		},
+		"cidr_block": schema.StringAttribute{
+			Computed:    true,
+			Description: "CIDR block defining the IP address range for the network.",
+		},
+		"gateway": schema.StringAttribute{
+			Computed:    true,
+			Description: "The default gateway IP address for the network.",
+		},
+		"connected_vms": schema.StringAttribute{
+			Computed:    true,
+			Description: "The number of virtual machines currently connected to this network.",
+		},
+		"network_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "Type of network: either 'standard' or 'custom'.",
+		},
+		"datacenter_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Unique identifier of the datacenter where the network is located.",
+		},
+		"datacenter": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the datacenter where the network is located.",
+		},
+		"region": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the region where the network is located.",
+		},
+		"country": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the country where the network is located.",
+		},
+		"dns_servers": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "List of DNS server addresses served to the network.",
+		},
+		"ipv6_cidr_block": schema.StringAttribute{
+			Computed:    true,
+			Description: "IPv6 CIDR block of the network. Not set for IPv4-only networks.",
+		},
+		"ipv6_gateway": schema.StringAttribute{
+			Computed:    true,
+			Description: "The IPv6 gateway address of the network.",
+		},
+		"ipv6_address_mode": schema.StringAttribute{
+			Computed:    true,
+			Description: "How IPv6 addresses are assigned: 'slaac', 'dhcpv6-stateful', or 'dhcpv6-stateless'.",
+		},
+		"routes": schema.SetNestedAttribute{
+			Computed:    true,
+			Description: "Static routes advertised to the network.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"destination": schema.StringAttribute{
+						Computed:    true,
+						Description: "Destination CIDR block of the route.",
+					},
+					"next_hop": schema.StringAttribute{
+						Computed:    true,
+						Description: "IP address within the network that traffic for the destination is sent to.",
+					},
+				},
+			},
+		},
+		"allocation_pools": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "DHCP allocation pools of the network.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"start": schema.StringAttribute{
+						Computed:    true,
+						Description: "Starting IP address of the allocation pool.",
+					},
+					"end": schema.StringAttribute{
+						Computed:    true,
+						Description: "Ending IP address of the allocation pool.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *networksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *networksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, networks.LogStartingReadGPCNNetworksDataSource)
+	var state networksDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listNetworksResponse, err := networks.ListNetworks(d.client, ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			networks.ErrSummaryUnableToListNetwork,
+			err.Error(),
+		)
+		return
+	}
+
+	networkList := []networks.NetworkDataResponseTF{}
+	for _, data := range listNetworksResponse {
+		network := networks.MapNetworkDataToTF(ctx, data)
+		if !state.DatacenterId.IsNull() && network.DatacenterId.ValueString() != state.DatacenterId.ValueString() {
+			continue
+		}
+		if !state.NetworkType.IsNull() && network.NetworkType.ValueString() != state.NetworkType.ValueString() {
+			continue
+		}
+		networkList = append(networkList, network)
+	}
+
+	state.Networks, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: networks.NetworkDataResponseTF{}.AttrTypes()}, networkList)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, networks.LogSuccessfullyFinishedReadGPCNNetworksDataSource)
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestNetworksDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A standard and a custom network, listed with and without the network type filter
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "standard" {
+  name          = "tfacc-ds-networks-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_network" "custom" {
+  name          = "tfacc-ds-networks-custom"
+  network_type  = "custom"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+}
+
+data "gpcn_networks" "all" {
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  depends_on    = [gpcn_network.standard, gpcn_network.custom]
+}
+
+data "gpcn_networks" "standard" {
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  network_type  = "standard"
+  depends_on    = [gpcn_network.standard, gpcn_network.custom]
+}
+
+data "gpcn_networks" "custom" {
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  network_type  = "custom"
+  depends_on    = [gpcn_network.standard, gpcn_network.custom]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Without a type filter both networks are listed
+					resource.TestCheckTypeSetElemNestedAttrs("data.gpcn_networks.all", "networks.*", map[string]string{"name": "tfacc-ds-networks-standard"}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gpcn_networks.all", "networks.*", map[string]string{"name": "tfacc-ds-networks-custom"}),
+					testCheckEveryListElementAttr("data.gpcn_networks.all", "networks", "datacenter_id", testEquals("1ea6b709-0671-46fa-aea8-bdc8eb897d3d")),
+					// Each type filter only returns networks of that type
+					resource.TestCheckTypeSetElemNestedAttrs("data.gpcn_networks.standard", "networks.*", map[string]string{"name": "tfacc-ds-networks-standard"}),
+					testCheckEveryListElementAttr("data.gpcn_networks.standard", "networks", "network_type", testEquals("standard")),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gpcn_networks.custom", "networks.*", map[string]string{"name": "tfacc-ds-networks-custom"}),
+					testCheckEveryListElementAttr("data.gpcn_networks.custom", "networks", "network_type", testEquals("custom")),
+				),
+			},
+		},
+	})
+}
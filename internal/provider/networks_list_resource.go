@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-gpcn/internal/networks"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &networksListResource{}
+	_ list.ListResourceWithConfigure = &networksListResource{}
+)
+
+// NewNetworksListResource is a helper function to simplify the provider implementation.
+func NewNetworksListResource() list.ListResource {
+	return &networksListResource{}
+}
+
+// networksListResource is the list resource implementation, used by terraform query to discover existing networks.
+type networksListResource struct {
+	client *http.Client
+}
+
+type networksListResourceModel struct {
+	DatacenterId types.String `tfsdk:"datacenter_id"`
+	NetworkType  types.String `tfsdk:"network_type"`
+}
+
+// Metadata returns the resource type name. It must match the managed resource.
+func (r *networksListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network"
+}
+
+// ListResourceConfigSchema defines the filters accepted by list blocks.
+func (r *networksListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists existing GPCN networks, including ones not managed by Terraform, so they can be imported",
+		Attributes: map[string]schema.Attribute{
+			"datacenter_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list networks in the datacenter with this unique identifier",
+			},
+			"network_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list networks of this type: either 'standard' or 'custom'",
+				Validators: []validator.String{
+					stringvalidator.OneOf(networks.NETWORK_TYPE_STANDARD, networks.NETWORK_TYPE_CUSTOM),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *networksListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// List streams every network matching the filters, with its identity and, when requested, its attributes.
+func (r *networksListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Info(ctx, networks.LogStartingListGPCNNetworks)
+	var config networksListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	listNetworksResponse, err := networks.ListNetworks(r.client, ctx)
+	if err != nil {
+		diags.AddError(
+			networks.ErrSummaryUnableToListNetwork,
+			err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, data := range listNetworksResponse {
+			network := networks.MapNetworkDataToTF(ctx, data)
+			if !config.DatacenterId.IsNull() && network.DatacenterId.ValueString() != config.DatacenterId.ValueString() {
+				continue
+			}
+			if !config.NetworkType.IsNull() && network.NetworkType.ValueString() != config.NetworkType.ValueString() {
+				continue
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = network.Name.ValueString()
+			result.Diagnostics.Append(setResourceIdentity(ctx, result.Identity, network.ID, network.DatacenterId)...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, networks.MapNetworkDataToModel(ctx, data))...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+		tflog.Info(ctx, networks.LogSuccessfullyFinishedListGPCNNetworks)
+	}
+}
@@ -2,15 +2,24 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
 	"terraform-provider-gpcn/internal/networks"
+	"terraform-provider-gpcn/internal/virtualmachines"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -22,9 +31,13 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &networksResource{}
-	_ resource.ResourceWithConfigure   = &networksResource{}
-	_ resource.ResourceWithImportState = &networksResource{}
+	_ resource.Resource                     = &networksResource{}
+	_ resource.ResourceWithConfigure        = &networksResource{}
+	_ resource.ResourceWithImportState      = &networksResource{}
+	_ resource.ResourceWithIdentity         = &networksResource{}
+	_ resource.ResourceWithModifyPlan       = &networksResource{}
+	_ resource.ResourceWithConfigValidators = &networksResource{}
+	_ resource.ResourceWithUpgradeState     = &networksResource{}
 )
 
 // NewNetworksResource is a helper function to simplify the provider implementation.
@@ -46,6 +59,8 @@ func (r *networksResource) Metadata(_ context.Context, req resource.MetadataRequ
 func (r *networksResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a private network to connect virtual machines within the same datacenter",
+		// Version 1 turned dns_servers from a delimited string into a list
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the network in UUID format",
@@ -86,7 +101,7 @@ func (r *networksResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"cidr_block": schema.StringAttribute{
-				Description: "CIDR block defining the IP address range for the network (e.g., 10.0.0.0/24)",
+				Description: "CIDR block defining the IP address range for the network (e.g., 10.0.0.0/24), with a prefix length between /16 and /29",
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.String{
@@ -138,20 +153,22 @@ func (r *networksResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					mapplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"dns_servers": schema.StringAttribute{
-				Description: "Comma-separated list of DNS server IPv4 addresses. Only applicable for standard networks",
+			"dns_servers": schema.ListAttribute{
+				Description: "List of DNS server IPv4 or IPv6 addresses handed out to the network. Only applicable for standard networks",
+				ElementType: types.StringType,
 				Optional:    true,
 				Computed:    true,
-				Validators: []validator.String{
+				Validators: []validator.List{
 					networks.StandardNetworkValidator{},
-					networks.DNSServersValidator{},
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(networks.DNSServerValidator{}),
 				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"dhcp_start_address": schema.StringAttribute{
-				Description: "Starting IP address of the DHCP range. Must be specified together with dhcp_end_address. Only applicable for standard networks",
+				Description: "Starting IP address of the DHCP range. Must be specified together with dhcp_end_address. Shorthand for a single entry in allocation_pools. Only applicable for standard networks",
 				Optional:    true,
 				Validators: []validator.String{
 					stringvalidator.AlsoRequires(path.MatchRoot("dhcp_end_address")),
@@ -160,7 +177,7 @@ func (r *networksResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"dhcp_end_address": schema.StringAttribute{
-				Description: "Ending IP address of the DHCP range. Must be specified together with dhcp_start_address. Only applicable for standard networks",
+				Description: "Ending IP address of the DHCP range. Must be specified together with dhcp_start_address. Shorthand for a single entry in allocation_pools. Only applicable for standard networks",
 				Optional:    true,
 				Validators: []validator.String{
 					stringvalidator.AlsoRequires(path.MatchRoot("dhcp_start_address")),
@@ -168,10 +185,138 @@ func (r *networksResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					networks.IpAddressValidator{},
 				},
 			},
+			"allocation_pools": schema.ListNestedAttribute{
+				Description: "DHCP ranges handed out to the network. Each range must lie within cidr_block and must not overlap another range. Conflicts with dhcp_start_address and dhcp_end_address, and defaults to the range they describe. Only applicable for standard networks",
+				Optional:    true,
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start": schema.StringAttribute{
+							Description: "Starting IP address of the allocation pool",
+							Required:    true,
+							Validators: []validator.String{
+								networks.IpAddressValidator{},
+							},
+						},
+						"end": schema.StringAttribute{
+							Description: "Ending IP address of the allocation pool",
+							Required:    true,
+							Validators: []validator.String{
+								networks.IpAddressValidator{},
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("dhcp_start_address"), path.MatchRoot("dhcp_end_address")),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipv6_cidr_block": schema.StringAttribute{
+				Description: "IPv6 CIDR block of the network (e.g., 2001:db8:0:1::/64), with a prefix length between /48 and /64. Adds IPv6 to the network next to cidr_block, making it dual-stack",
+				Optional:    true,
+				Validators: []validator.String{
+					networks.IPv6CIDRValidator{},
+				},
+			},
+			"ipv6_gateway": schema.StringAttribute{
+				Description: "The IPv6 gateway address of the network. Not set for IPv4-only networks",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipv6_address_mode": schema.StringAttribute{
+				Description: "How IPv6 addresses are assigned: 'slaac', 'dhcpv6-stateful', or 'dhcpv6-stateless'. Defaults to 'slaac' when ipv6_cidr_block is set, which requires a /64 prefix",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("ipv6_cidr_block")),
+					stringvalidator.OneOf(networks.IPV6_ADDRESS_MODE_SLAAC, networks.IPV6_ADDRESS_MODE_DHCPV6_STATEFUL, networks.IPV6_ADDRESS_MODE_DHCPV6_STATELESS),
+				},
+			},
+			"routes": schema.SetNestedAttribute{
+				Description: "Static routes advertised to the network, for destinations reached through a virtual machine on the network such as a VPN appliance. The next hop of each route must lie within cidr_block, or ipv6_cidr_block for IPv6 routes. Use default_route for the default route",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"destination": schema.StringAttribute{
+							Description: "Destination CIDR block of the route (e.g., 192.168.10.0/24)",
+							Required:    true,
+						},
+						"next_hop": schema.StringAttribute{
+							Description: "IP address within the network that traffic for the destination is sent to",
+							Required:    true,
+						},
+					},
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"detach_on_destroy": schema.BoolAttribute{
+				Description: "Whether destroying the network first detaches it from the virtual machines it is attached to, including ones managed elsewhere. Running virtual machines are stopped for the detach and started again, and every virtual machine touched is reported. When the network is the primary network of a virtual machine, the only other network of that virtual machine becomes the primary; with more networks left, set 'primary_network_id' of the virtual machine first. When false, destroying a network that is still attached fails and lists the attached virtual machines. Defaults to false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"default_route": schema.StringAttribute{
+				Description: "Default route IP address advertised to the network. Must lie within cidr_block. Defaults to the gateway derived from cidr_block, i.e. the first address after the network address",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("cidr_block")),
+					networks.IpAddressValidator{},
+				},
+			},
+			"default_route_enabled": schema.BoolAttribute{
+				Description: "Whether the default route is advertised to the network. Defaults to true for standard networks and false for custom networks",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Bool{
+					networks.CIDRBlockRequiredValidator{},
+				},
+			},
+			"dhcp_enabled": schema.BoolAttribute{
+				Description: "Whether the network runs a DHCP server for the DHCP range. Defaults to true for standard networks and false for custom networks",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Bool{
+					networks.CIDRBlockRequiredValidator{},
+				},
+			},
+			"serve_dns_enabled": schema.BoolAttribute{
+				Description: "Whether dns_servers are handed out to the network over DHCP. Defaults to true for standard networks and false for custom networks",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Bool{
+					networks.CIDRBlockRequiredValidator{},
+				},
+			},
+			"snat_enabled": schema.BoolAttribute{
+				Description: "Whether Source Network Address Translation (SNAT) gives the network outbound access. Defaults to true for standard networks and false for custom networks",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Bool{
+					networks.CIDRBlockRequiredValidator{},
+				},
+			},
 		},
 	}
 }
 
+// ConfigValidators checks the addresses of the network against each other, which attribute validators cannot do on their own.
+func (r *networksResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		networks.AddressRangeConfigValidator{},
+		networks.IPv6ConfigValidator{},
+		networks.RoutesConfigValidator{},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *networksResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -221,6 +366,12 @@ func (r *networksResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	diags = setResourceIdentity(ctx, resp.Identity, plan.ID, plan.DatacenterId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, networks.LogSuccessfullyFinishedCreateGPCNNetwork)
 }
 
@@ -250,6 +401,12 @@ func (r *networksResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	diags = setResourceIdentity(ctx, resp.Identity, state.ID, state.DatacenterId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Info(ctx, networks.LogSuccessfullyFinishedReadGPCNNetwork)
 }
 
@@ -282,6 +439,12 @@ func (r *networksResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	diags = setResourceIdentity(ctx, resp.Identity, plan.ID, plan.DatacenterId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, networks.LogSuccessfullyFinishedUpdateGPCNNetwork)
 }
 
@@ -295,7 +458,35 @@ func (r *networksResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	err := networks.DeleteNetwork(r.client, ctx, state.ID.ValueString())
+	// Virtual machines attached to the network may belong to other configurations, so they are only detached when asked to
+	attachedVirtualMachines, err := networks.GetVirtualMachinesAttachedToNetworks(r.client, ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete GPCN Network with ID "+state.ID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+	if len(attachedVirtualMachines.Data) > 0 {
+		if !state.DetachOnDestroy.ValueBool() {
+			var attached []string
+			for _, virtualMachine := range attachedVirtualMachines.Data {
+				attached = append(attached, fmt.Sprintf("- %s (ID: %s)", virtualMachine.Name, virtualMachine.ID))
+			}
+			resp.Diagnostics.AddError(
+				networks.ErrSummaryNetworkStillAttached,
+				fmt.Sprintf(networks.ErrDetailNetworkStillAttached, state.ID.ValueString(), len(attached), strings.Join(attached, "\n")),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(r.detachFromVirtualMachines(ctx, state.ID.ValueString(), attachedVirtualMachines.Data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	err = networks.DeleteNetwork(r.client, ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete GPCN Network with ID "+state.ID.ValueString(),
@@ -307,6 +498,265 @@ func (r *networksResource) Delete(ctx context.Context, req resource.DeleteReques
 	tflog.Info(ctx, networks.LogSuccessfullyFinishedDeleteGPCNNetwork)
 }
 
+// detachFromVirtualMachines detaches the network from each virtual machine before the network is deleted. Running virtual
+// machines are stopped for the detach and started again afterwards, even when the detach fails. Every virtual machine touched
+// is reported, as a warning when all detaches succeed and as part of the error otherwise.
+func (r *networksResource) detachFromVirtualMachines(ctx context.Context, networkId string, attachedVirtualMachines []networks.ReadNetworksToVMsDataResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var detached, failed []string
+	for _, virtualMachine := range attachedVirtualMachines {
+		tflog.Info(ctx, fmt.Sprintf(networks.LogDetachingNetworkFromVM, networkId, virtualMachine.ID))
+		steps, err := r.detachFromVirtualMachine(ctx, networkId, virtualMachine.ID)
+		report := fmt.Sprintf("- %s (ID: %s): %s", virtualMachine.Name, virtualMachine.ID, strings.Join(steps, ", "))
+		if err != nil {
+			failed = append(failed, report+": "+err.Error())
+			continue
+		}
+		detached = append(detached, report)
+		tflog.Info(ctx, fmt.Sprintf(networks.LogSuccessfullyDetachedNetworkVM, networkId, virtualMachine.ID))
+	}
+
+	if len(failed) > 0 {
+		if len(detached) == 0 {
+			detached = append(detached, "- none")
+		}
+		diags.AddError(
+			networks.ErrSummaryUnableToDetachNetwork,
+			fmt.Sprintf(networks.ErrDetailDetachNetworkFailed, networkId, strings.Join(detached, "\n"), strings.Join(failed, "\n")),
+		)
+		return diags
+	}
+
+	diags.AddWarning(
+		networks.WarnSummaryDetachedNetwork,
+		fmt.Sprintf(networks.WarnDetailDetachedNetwork, networkId, strings.Join(detached, "\n")),
+	)
+	return diags
+}
+
+// detachFromVirtualMachine removes the network interface of the network from a single virtual machine. When it is the primary
+// interface, the only other network takes over, the same way primary_network_id of gpcn_virtualmachine defaults to the only
+// network. With several networks left, the new primary has to be chosen on the virtual machine instead, so the detach fails
+// before the virtual machine is stopped. It returns the steps that were taken, for reporting.
+func (r *networksResource) detachFromVirtualMachine(ctx context.Context, networkId, virtualMachineId string) (steps []string, err error) {
+	networkInterfaces, err := networks.GetNetworkInterfaces(r.client, ctx, virtualMachineId)
+	if err != nil {
+		return steps, err
+	}
+	interfaceIdx := slices.IndexFunc(networkInterfaces, func(inter networks.ReadVirtualMachineNetworkDataResponseTF) bool {
+		return inter.NetworkID.ValueString() == networkId
+	})
+	// Detached in the meantime, for example by another apply
+	if interfaceIdx < 0 {
+		steps = append(steps, "already detached")
+		return steps, nil
+	}
+
+	var newPrimaryNetworkId string
+	if networkInterfaces[interfaceIdx].IsPrimary.ValueInt64() == 1 {
+		otherNetworkIds := slices.DeleteFunc(virtualmachines.NetworksOfInterfaces(networkInterfaces), func(otherNetworkId string) bool {
+			return otherNetworkId == networkId
+		})
+		switch len(otherNetworkIds) {
+		case 0:
+			return steps, errors.New(networks.ErrDetailOnlyNetworkOfVM)
+		case 1:
+			newPrimaryNetworkId = otherNetworkIds[0]
+		default:
+			return steps, fmt.Errorf(networks.ErrDetailPrimaryNetworkOfVM, len(otherNetworkIds))
+		}
+	}
+
+	virtualMachine, err := virtualmachines.GetVirtualMachine(r.client, ctx, virtualMachineId)
+	if err != nil {
+		return steps, err
+	}
+
+	// Network interfaces can only be changed while the virtual machine is stopped
+	if virtualMachine.Data.Status == virtualmachines.Running {
+		err = virtualmachines.StopVirtualMachine(r.client, ctx, virtualMachineId)
+		if err != nil {
+			return steps, fmt.Errorf(virtualmachines.ErrDetailStoppingVM+": %w", virtualMachineId, err)
+		}
+		steps = append(steps, "stopped")
+
+		defer func() {
+			startErr := virtualmachines.StartVirtualMachine(r.client, ctx, virtualMachineId, true)
+			if startErr != nil {
+				steps = append(steps, "left stopped")
+				err = errors.Join(err, fmt.Errorf(virtualmachines.ErrDetailStartingVM+": %w", virtualMachineId, startErr))
+				return
+			}
+			steps = append(steps, "started")
+		}()
+	}
+
+	// A public IP can only be held by the primary interface, so it moves along
+	if newPrimaryNetworkId != "" {
+		hasPublicIp := networkInterfaces[interfaceIdx].PublicIP.ValueString() != ""
+		err = networks.SwitchPrimaryNetworkInterface(r.client, ctx, virtualMachineId, newPrimaryNetworkId, hasPublicIp, networkInterfaces)
+		if err != nil {
+			return steps, err
+		}
+		steps = append(steps, "moved primary interface")
+	}
+
+	err = networks.RemoveNetworkInterface(r.client, ctx, virtualMachineId, networkInterfaces[interfaceIdx].ID.ValueString())
+	if err != nil {
+		return steps, err
+	}
+	steps = append(steps, "detached")
+
+	return steps, nil
+}
+
+// ModifyPlan fills in the routing and DHCP settings that are not configured. The toggles follow the network_type, the default
+// route is derived from cidr_block, allocation_pools follows the DHCP start and end shorthand, and the IPv6 address mode defaults
+// to SLAAC, so changing any of them updates the settings that were left to their defaults.
+func (r *networksResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to fill in when the network is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	tflog.Info(ctx, networks.LogStartingModifyPlanGPCNNetwork)
+	var plan networks.ResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config networks.ResourceModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.NetworkType.IsUnknown() {
+		isStandardNetwork := types.BoolValue(plan.NetworkType.ValueString() == networks.NETWORK_TYPE_STANDARD)
+		if config.DefaultRouteEnabled.IsNull() {
+			plan.DefaultRouteEnabled = isStandardNetwork
+		}
+		if config.DHCPEnabled.IsNull() {
+			plan.DHCPEnabled = isStandardNetwork
+		}
+		if config.ServeDNSEnabled.IsNull() {
+			plan.ServeDNSEnabled = isStandardNetwork
+		}
+		if config.SNATEnabled.IsNull() {
+			plan.SNATEnabled = isStandardNetwork
+		}
+	}
+
+	// The DHCP start and end shorthand describes a single allocation pool
+	if config.AllocationPools.IsNull() && !config.DHCPStartAddress.IsNull() && !config.DHCPEndAddress.IsNull() {
+		if config.DHCPStartAddress.IsUnknown() || config.DHCPEndAddress.IsUnknown() {
+			plan.AllocationPools = types.ListUnknown(types.ObjectType{AttrTypes: networks.AllocationPoolTF{}.AttrTypes()})
+		} else {
+			plan.AllocationPools = networks.MapAllocationPoolsToList(ctx, networks.AllocationPoolsFromModel(ctx, config))
+		}
+	}
+
+	// IPv6 addresses are assigned with SLAAC unless configured otherwise, and the IPv6 gateway follows the IPv6 CIDR block
+	if !config.IPv6CIDRBlock.IsUnknown() {
+		if config.IPv6AddressMode.IsNull() {
+			plan.IPv6AddressMode = types.StringNull()
+			if !config.IPv6CIDRBlock.IsNull() {
+				plan.IPv6AddressMode = types.StringValue(networks.IPV6_ADDRESS_MODE_SLAAC)
+			}
+		}
+		if config.IPv6CIDRBlock.IsNull() {
+			plan.IPv6Gateway = types.StringNull()
+		}
+	}
+	if !req.State.Raw.IsNull() {
+		var state networks.ResourceModel
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !config.IPv6CIDRBlock.IsNull() && !config.IPv6CIDRBlock.Equal(state.IPv6CIDRBlock) {
+			plan.IPv6Gateway = types.StringUnknown()
+		}
+	}
+
+	// Networks without a configured CIDR block have no gateway to route to
+	if config.DefaultRoute.IsNull() && !config.CIDRBlock.IsUnknown() {
+		if config.CIDRBlock.IsNull() {
+			plan.DefaultRoute = types.StringNull()
+		} else {
+			gateway, err := networks.DefaultGatewayForCIDR(config.CIDRBlock.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("cidr_block"),
+					networks.ErrSummaryInvalidAttr,
+					fmt.Sprintf(networks.ErrDetailUnableToDeriveDefaultRoute, err.Error()),
+				)
+				return
+			}
+			plan.DefaultRoute = types.StringValue(gateway)
+		}
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// UpgradeState migrates state written by earlier schema versions.
+func (r *networksResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored dns_servers as a string delimited by a comma and a space
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                 schema.StringAttribute{Computed: true},
+					"name":               schema.StringAttribute{Required: true},
+					"description":        schema.StringAttribute{Optional: true, Computed: true},
+					"created_time":       schema.StringAttribute{Computed: true},
+					"last_updated":       schema.StringAttribute{Computed: true},
+					"snat":               schema.StringAttribute{Computed: true},
+					"cidr_block":         schema.StringAttribute{Optional: true, Computed: true},
+					"gateway":            schema.StringAttribute{Computed: true},
+					"connected_vms":      schema.StringAttribute{Computed: true},
+					"network_type":       schema.StringAttribute{Required: true},
+					"datacenter_id":      schema.StringAttribute{Required: true},
+					"location":           schema.MapAttribute{ElementType: types.StringType, Computed: true},
+					"dns_servers":        schema.StringAttribute{Optional: true, Computed: true},
+					"dhcp_start_address": schema.StringAttribute{Optional: true},
+					"dhcp_end_address":   schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				tflog.Info(ctx, networks.LogStartingUpgradeStateGPCNNetworkV0)
+				var prior networks.ResourceModelV0
+				diags := req.State.Get(ctx, &prior)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				diags = resp.State.Set(ctx, networks.UpgradeResourceModelV0(ctx, prior))
+				resp.Diagnostics.Append(diags...)
+			},
+		},
+	}
+}
+
+// IdentitySchema defines the identity used by import blocks and list resources.
+func (r *networksResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema("Unique identifier for the network in UUID format")
+}
+
+// ImportState accepts the ID, "name:<name>", "<datacenter_name>/<name>", or an identity.
 func (r *networksResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByIdOrName(ctx, r.client, req, resp, networks.ErrSummaryUnableToImportNetwork, func(datacenterId, name string) (string, string, error) {
+		network, err := networks.GetNetworkByName(r.client, ctx, datacenterId, name)
+		if err != nil {
+			return "", "", err
+		}
+		return network.ID, network.Datacenter.ID, nil
+	})
 }
@@ -5,11 +5,18 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestNetworksResource(t *testing.T) {
 	gpcnNetworksTest := "gpcn_network.test"
+	importIdByName, checkImportedByName := testImportStateByAttrs(gpcnNetworksTest, "name:%s", "name")
+	importIdByDatacenterName, checkImportedByDatacenterName := testImportStateByAttrs(gpcnNetworksTest, "%s/%s", "location.datacenter", "name")
+	importIdByDatacenterAlias, checkImportedByDatacenterAlias := testImportStateByAttrs(gpcnNetworksTest, "dc:%s/%s", "location.datacenter", "name")
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -31,7 +38,7 @@ resource "gpcn_network" "test" {
   dhcp_start_address = "10.0.0.10"
   dhcp_end_address   = "10.0.0.254"
 
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -41,9 +48,17 @@ resource "gpcn_network" "test" {
 					resource.TestCheckResourceAttr(gpcnNetworksTest, "description", "An example Network for a demo of Terraform! This one uses the standard network_type."),
 					resource.TestCheckResourceAttr(gpcnNetworksTest, "dhcp_end_address", "10.0.0.254"),
 					resource.TestCheckResourceAttr(gpcnNetworksTest, "dhcp_start_address", "10.0.0.10"),
-					resource.TestCheckResourceAttr(gpcnNetworksTest, "dns_servers", "8.8.8.8, 8.8.4.4"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "dns_servers.#", "2"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "dns_servers.0", "8.8.8.8"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "dns_servers.1", "8.8.4.4"),
 					resource.TestCheckResourceAttr(gpcnNetworksTest, "name", "terraform-demo-standard"),
 					resource.TestCheckResourceAttr(gpcnNetworksTest, "network_type", "standard"),
+					// Verify routing and DHCP settings default from the network_type and cidr_block
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "default_route", "10.0.0.1"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "default_route_enabled", "true"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "dhcp_enabled", "true"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "serve_dns_enabled", "true"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "snat_enabled", "true"),
 					// Verify generated values are generated
 					resource.TestCheckResourceAttrSet(gpcnNetworksTest, "id"),
 					resource.TestCheckResourceAttrSet(gpcnNetworksTest, "last_updated"),
@@ -67,6 +82,27 @@ resource "gpcn_network" "test" {
 				ResourceName: gpcnNetworksTest,
 				ImportState:  true,
 			},
+			// ImportState testing by name, searching every datacenter
+			{
+				ResourceName:      gpcnNetworksTest,
+				ImportState:       true,
+				ImportStateIdFunc: importIdByName,
+				ImportStateCheck:  checkImportedByName,
+			},
+			// ImportState testing by datacenter name and name
+			{
+				ResourceName:      gpcnNetworksTest,
+				ImportState:       true,
+				ImportStateIdFunc: importIdByDatacenterName,
+				ImportStateCheck:  checkImportedByDatacenterName,
+			},
+			// ImportState testing by datacenter name and name, with the dc: alias
+			{
+				ResourceName:      gpcnNetworksTest,
+				ImportState:       true,
+				ImportStateIdFunc: importIdByDatacenterAlias,
+				ImportStateCheck:  checkImportedByDatacenterAlias,
+			},
 			// Update and Read testing with little changes
 			{
 				Config: providerConfig + `
@@ -85,13 +121,16 @@ resource "gpcn_network" "test" {
   dhcp_start_address = "10.0.0.10"
   dhcp_end_address   = "10.0.0.140"
 
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 			`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify attributes are set to the values from the config
 					resource.TestCheckResourceAttr(gpcnNetworksTest, "datacenter_id", "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"),
 					resource.TestCheckResourceAttr(gpcnNetworksTest, "dhcp_end_address", "10.0.0.140"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "allocation_pools.#", "1"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "allocation_pools.0.start", "10.0.0.10"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "allocation_pools.0.end", "10.0.0.140"),
 					resource.TestCheckResourceAttr(gpcnNetworksTest, "name", "terraform-demo-standard"),
 					resource.TestCheckResourceAttr(gpcnNetworksTest, "network_type", "standard"),
 					// Verify generated values are generated
@@ -104,6 +143,44 @@ resource "gpcn_network" "test" {
 					},
 				},
 			},
+			// Update and Read testing with several allocation pools instead of the DHCP range shorthand
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-standard"
+
+  description = "An example Network for a demo of Terraform! This one uses the standard network_type."
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/24"
+
+  allocation_pools = [
+    { start = "10.0.0.10", end = "10.0.0.99" },
+    { start = "10.0.0.150", end = "10.0.0.199" },
+  ]
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "allocation_pools.#", "2"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "allocation_pools.0.start", "10.0.0.10"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "allocation_pools.0.end", "10.0.0.99"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "allocation_pools.1.start", "10.0.0.150"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "allocation_pools.1.end", "10.0.0.199"),
+					resource.TestCheckNoResourceAttr(gpcnNetworksTest, "dhcp_start_address"),
+					resource.TestCheckNoResourceAttr(gpcnNetworksTest, "dhcp_end_address"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(gpcnNetworksTest, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
 			// Update and Read testing with a replace
 			{
 				Config: providerConfig + `
@@ -123,6 +200,11 @@ resource "gpcn_network" "test" {
 					resource.TestCheckResourceAttr(gpcnNetworksTest, "datacenter_id", "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"),
 					resource.TestCheckResourceAttr(gpcnNetworksTest, "name", "terraform-demo-custom"),
 					resource.TestCheckResourceAttr(gpcnNetworksTest, "network_type", "custom"),
+					resource.TestCheckNoResourceAttr(gpcnNetworksTest, "default_route"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "default_route_enabled", "false"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "dhcp_enabled", "false"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "serve_dns_enabled", "false"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "snat_enabled", "false"),
 					// Verify generated values are generated
 					resource.TestCheckResourceAttrSet(gpcnNetworksTest, "id"),
 				),
@@ -137,6 +219,279 @@ resource "gpcn_network" "test" {
 	})
 }
 
+func TestNetworksResourceUpgradeFromV0(t *testing.T) {
+	gpcnNetworksTest := "gpcn_network.test"
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			// Create the network with the last release that stored dns_servers as a delimited string
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"gpcn": {
+						Source:            "Global-Private-Cloud-Network/gpcn",
+						VersionConstraint: "0.1.2",
+					},
+				},
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-upgrade"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/24"
+
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+
+  dns_servers = "8.8.8.8, 8.8.4.4"
+}
+`,
+			},
+			// The upgraded state must match the same network written with the list syntax
+			{
+				ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-upgrade"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/24"
+
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "dns_servers.#", "2"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "dns_servers.0", "8.8.8.8"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "dns_servers.1", "8.8.4.4"),
+					resource.TestCheckResourceAttr(gpcnNetworksTest, "default_route", "10.0.0.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestNetworksResourceDetachOnDestroy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a virtual machine attached to both networks
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "primary" {
+  name          = "terraform-demo-detach-primary"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_network" "detach" {
+  name          = "terraform-demo-detach"
+  network_type  = "custom"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-demo-detach-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup   = false
+  allocate_public_ip = false
+  network_ids        = [gpcn_network.primary.id, gpcn_network.detach.id]
+  primary_network_id = gpcn_network.primary.id
+
+  # Stands in for a virtual machine managed by another configuration
+  lifecycle {
+    ignore_changes = [network_ids]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gpcn_network.detach", "detach_on_destroy", "false"),
+				),
+			},
+			// Validate destroying a network that is still attached fails and names the virtual machine
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "primary" {
+  name          = "terraform-demo-detach-primary"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-demo-detach-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup   = false
+  allocate_public_ip = false
+  network_ids        = [gpcn_network.primary.id]
+
+  # Stands in for a virtual machine managed by another configuration
+  lifecycle {
+    ignore_changes = [network_ids]
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("(?s)is attached to 1 virtual machine.*terraform-demo-detach-vm"),
+			},
+			// Opt in to detaching the network on destroy
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "primary" {
+  name          = "terraform-demo-detach-primary"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_network" "detach" {
+  name          = "terraform-demo-detach"
+  network_type  = "custom"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  detach_on_destroy = true
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-demo-detach-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup   = false
+  allocate_public_ip = false
+  network_ids        = [gpcn_network.primary.id]
+
+  # Stands in for a virtual machine managed by another configuration
+  lifecycle {
+    ignore_changes = [network_ids]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gpcn_network.detach", "detach_on_destroy", "true"),
+				),
+			},
+			// Destroying the network now detaches it, and the virtual machine stays
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "primary" {
+  name          = "terraform-demo-detach-primary"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-demo-detach-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup   = false
+  allocate_public_ip = false
+  network_ids        = [gpcn_network.primary.id]
+
+  # Stands in for a virtual machine managed by another configuration
+  lifecycle {
+    ignore_changes = [network_ids]
+  }
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("gpcn_network.detach", plancheck.ResourceActionDestroy),
+						plancheck.ExpectResourceAction("gpcn_virtualmachine.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gpcn_virtualmachine.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+// Networks with routes keep detach_on_destroy as configured, after apply and after a refresh
+func TestNetworksResourceDetachOnDestroyWithRoutes(t *testing.T) {
+	config := providerConfig + `
+resource "gpcn_network" "test" {
+  name          = "terraform-demo-detach-routes"
+  network_type  = "custom"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block    = "10.0.0.0/24"
+
+  routes = [
+    { destination = "192.168.10.0/24", next_hop = "10.0.0.5" },
+  ]
+
+  detach_on_destroy = true
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("gpcn_network.test", tfjsonpath.New("detach_on_destroy"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("gpcn_network.test", tfjsonpath.New("routes"), knownvalue.SetSizeExact(1)),
+				},
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gpcn_network.test", "detach_on_destroy", "true"),
+				),
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestNetworkTypeInvalid(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
@@ -178,7 +533,7 @@ resource "gpcn_network" "test" {
   dhcp_start_address = "10.0.0.10"
   dhcp_end_address   = "10.0.0.140"
 
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 `,
 				ExpectError: regexp.MustCompile(missingRequiredAttributeErr),
@@ -197,7 +552,7 @@ resource "gpcn_network" "test" {
   cidr_block = "10.0.0.0/24"
   dhcp_end_address   = "10.0.0.140"
 
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 `,
 				ExpectError: regexp.MustCompile(missingRequiredAttributeErr),
@@ -216,7 +571,7 @@ resource "gpcn_network" "test" {
   cidr_block = "10.0.0.0/24"
   dhcp_start_address = "10.0.0.10"
 
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 `,
 				ExpectError: regexp.MustCompile(missingRequiredAttributeErr),
@@ -262,7 +617,7 @@ resource "gpcn_network" "test" {
   dhcp_start_address = "10.0.0.1455"
   dhcp_end_address   = "10.0.0.140"
 
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 `,
 				ExpectError: regexp.MustCompile("does not resolve to a valid IPv4 address"),
@@ -282,7 +637,7 @@ resource "gpcn_network" "test" {
   dhcp_start_address = "10.0.0.10"
   dhcp_end_address   = "10.0.0.1405"
 
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 `,
 				ExpectError: regexp.MustCompile("does not resolve to a valid IPv4 address"),
@@ -302,7 +657,7 @@ resource "gpcn_network" "test" {
   dhcp_start_address = "192.0.0.10"
   dhcp_end_address   = "10.0.0.140"
 
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 `,
 				ExpectError: regexp.MustCompile("is not a valid IP address in the CIDR"),
@@ -311,11 +666,11 @@ resource "gpcn_network" "test" {
 	})
 }
 
-func TestCIDRValidator(t *testing.T) {
+func TestDefaultRouteValidation(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
-			// Validate error is shown when cidr_block is a not a valid CIDR block
+			// Validate error is shown when default_route is not within the CIDR block
 			{
 				Config: providerConfig + `
 resource "gpcn_network" "test" {
@@ -326,44 +681,42 @@ resource "gpcn_network" "test" {
 
   network_type = "standard"
 
-  cidr_block = "10.0.0.0/245"
-  dhcp_start_address = "10.0.0.10"
-  dhcp_end_address   = "10.0.0.140"
+  cidr_block = "192.168.0.0/24"
+  dhcp_start_address = "192.168.0.10"
+  dhcp_end_address   = "192.168.0.140"
 
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  default_route = "10.0.0.1"
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 `,
-				ExpectError: regexp.MustCompile("does not contain a valid CIDR block"),
+				ExpectError: regexp.MustCompile("is not a valid IP address in the CIDR"),
 			},
-			// Validate error is shown when dhcp_start_address is a valid IP address, but not in the CIDR block
+			// Validate error is shown when SNAT is enabled on a network without a CIDR block
 			{
 				Config: providerConfig + `
 resource "gpcn_network" "test" {
 
-  name = "terraform-demo-standard"
+  name = "terraform-demo-custom"
 
   datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
 
-  network_type = "standard"
-
-  cidr_block = "100.0.0.0/24"
-  dhcp_start_address = "10.0.0.10"
-  dhcp_end_address   = "10.0.0.140"
+  network_type = "custom"
 
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  snat_enabled = true
 }
 `,
-				ExpectError: regexp.MustCompile("is not a valid IP address in the CIDR"),
+				ExpectError: regexp.MustCompile("can only be enabled when 'cidr_block' is set"),
 			},
 		},
 	})
 }
 
-func TestDNSServersValidator(t *testing.T) {
+func TestAddressRangeConfigValidator(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
-			// Validate error is shown when dns_servers contains a DNS server that is not a valid IP address
+			// Validate error is shown when the DHCP range is reversed
 			{
 				Config: providerConfig + `
 resource "gpcn_network" "test" {
@@ -375,15 +728,35 @@ resource "gpcn_network" "test" {
   network_type = "standard"
 
   cidr_block = "10.0.0.0/24"
-  dhcp_start_address = "10.0.0.10"
+  dhcp_start_address = "10.0.0.140"
+  dhcp_end_address   = "10.0.0.10"
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`,
+				ExpectError: regexp.MustCompile("The DHCP range is reversed"),
+			},
+			// Validate error is shown when the DHCP range starts on the gateway
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-standard"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.1"
   dhcp_end_address   = "10.0.0.140"
 
-  dns_servers = "8.8.8.8, 8.8.4.4, 123.123.123.1234"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 `,
-				ExpectError: regexp.MustCompile("is not a valid IPv4 address"),
+				ExpectError: regexp.MustCompile("is the gateway of the CIDR block"),
 			},
-			// Validate error is shown when dns_servers contains a hanging comma
+			// Validate error is shown when the DHCP range ends on the broadcast address
 			{
 				Config: providerConfig + `
 resource "gpcn_network" "test" {
@@ -396,12 +769,646 @@ resource "gpcn_network" "test" {
 
   cidr_block = "10.0.0.0/24"
   dhcp_start_address = "10.0.0.10"
-  dhcp_end_address   = "10.0.0.140"
+  dhcp_end_address   = "10.0.0.255"
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`,
+				ExpectError: regexp.MustCompile("is the broadcast address of the CIDR block"),
+			},
+			// Validate error is shown when the prefix length is not supported
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-standard"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
 
-  dns_servers = "8.8.8.8, 8.8.4.4, "
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/30"
+  dhcp_start_address = "10.0.0.2"
+  dhcp_end_address   = "10.0.0.2"
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 `,
-				ExpectError: regexp.MustCompile("is not a valid IPv4 address"),
+				ExpectError: regexp.MustCompile("has a prefix length of /30"),
+			},
+		},
+	})
+}
+
+func TestAllocationPoolsValidator(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate error is shown when two allocation pools overlap
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-standard"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/24"
+  allocation_pools = [
+    { start = "10.0.0.10", end = "10.0.0.99" },
+    { start = "10.0.0.50", end = "10.0.0.199" },
+  ]
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`,
+				ExpectError: regexp.MustCompile("overlaps the allocation pool"),
+			},
+			// Validate error is shown when an allocation pool is not within the CIDR block
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-standard"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/24"
+  allocation_pools = [
+    { start = "10.0.0.10", end = "10.0.0.99" },
+    { start = "10.0.1.10", end = "10.0.1.99" },
+  ]
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`,
+				ExpectError: regexp.MustCompile("is not a valid IP address in the CIDR"),
+			},
+			// Validate error is shown when an allocation pool is reversed
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-standard"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/24"
+  allocation_pools = [
+    { start = "10.0.0.99", end = "10.0.0.10" },
+  ]
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`,
+				ExpectError: regexp.MustCompile("The DHCP range is reversed"),
+			},
+			// Validate error is shown when an allocation pool ends on a configured default route instead of the derived gateway
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-standard"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/24"
+  allocation_pools = [
+    { start = "10.0.0.10", end = "10.0.0.254" },
+  ]
+
+  default_route = "10.0.0.254"
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`,
+				ExpectError: regexp.MustCompile(`'10.0.0.254'\s+is\s+the\s+gateway`),
+			},
+			// Validate error is shown when allocation_pools is combined with the DHCP range shorthand
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-standard"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.99"
+  allocation_pools = [
+    { start = "10.0.0.150", end = "10.0.0.199" },
+  ]
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func TestIPv6Validators(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate error is shown when ipv6_cidr_block is an IPv4 CIDR block
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-dual-stack"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.140"
+
+  ipv6_cidr_block = "10.0.1.0/24"
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`,
+				ExpectError: regexp.MustCompile("does not contain a valid IPv6 CIDR block"),
+			},
+			// Validate error is shown when the IPv6 prefix length is not supported
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-dual-stack"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.140"
+
+  ipv6_cidr_block = "2001:db8::/32"
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`,
+				ExpectError: regexp.MustCompile("Networks support IPv6 prefix lengths from /48 to /64"),
+			},
+			// Validate error is shown when SLAAC is used without a /64 prefix
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-dual-stack"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.140"
+
+  ipv6_cidr_block = "2001:db8::/56"
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`,
+				ExpectError: regexp.MustCompile("requires 'ipv6_cidr_block' to have a prefix length of /64"),
+			},
+			// Validate error is shown when ipv6_address_mode is set without ipv6_cidr_block
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-dual-stack"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.140"
+
+  ipv6_address_mode = "dhcpv6-stateful"
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Validate error is shown when an IPv6 DNS server is used on an IPv4-only network
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-dual-stack"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.140"
+
+  dns_servers = ["8.8.8.8", "2001:4860:4860::8888"]
+}
+`,
+				ExpectError: regexp.MustCompile("can only be served to networks with an 'ipv6_cidr_block'"),
+			},
+		},
+	})
+}
+
+func TestRoutesConfigValidator(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate error is shown when routes are set without cidr_block
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-routes"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "custom"
+
+  routes = [
+    { destination = "192.168.10.0/24", next_hop = "10.0.0.5" },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile("can only be set when 'cidr_block' is set"),
+			},
+			// Validate error is shown when the next hop is not within cidr_block
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-routes"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "custom"
+
+  cidr_block = "10.0.0.0/24"
+
+  routes = [
+    { destination = "192.168.10.0/24", next_hop = "10.0.1.5" },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile("is not within the cidr_block"),
+			},
+			// Validate error is shown when the destination is not a network address
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-routes"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "custom"
+
+  cidr_block = "10.0.0.0/24"
+
+  routes = [
+    { destination = "192.168.10.1/24", next_hop = "10.0.0.5" },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile("is not a valid CIDR block"),
+			},
+			// Validate error is shown when the destination is the default route
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-routes"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "custom"
+
+  cidr_block = "10.0.0.0/24"
+
+  routes = [
+    { destination = "0.0.0.0/0", next_hop = "10.0.0.5" },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile("Use 'default_route'"),
+			},
+			// Validate error is shown when the same destination has two next hops
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-routes"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "custom"
+
+  cidr_block = "10.0.0.0/24"
+
+  routes = [
+    { destination = "192.168.10.0/24", next_hop = "10.0.0.5" },
+    { destination = "192.168.10.0/24", next_hop = "10.0.0.6" },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile("is used by more than one route"),
+			},
+			// Validate error is shown when an IPv6 route is added to an IPv4-only network
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-routes"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "custom"
+
+  cidr_block = "10.0.0.0/24"
+
+  routes = [
+    { destination = "2001:db8:ff::/48", next_hop = "10.0.0.5" },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile("is not of the same IP version"),
+			},
+			// Validate error is shown on the destination when it is the IPv6 default route
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-routes"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "custom"
+
+  cidr_block = "10.0.0.0/24"
+  ipv6_cidr_block = "2001:db8::/64"
+
+  routes = [
+    { destination = "::/0", next_hop = "2001:db8::5" },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`(?s)Use 'default_route'.*destination = "::/0"`),
+			},
+			// Validate error is shown on the next hop when it is the network address of cidr_block
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-routes"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "custom"
+
+  cidr_block = "10.0.0.0/24"
+
+  routes = [
+    { destination = "192.168.10.0/24", next_hop = "10.0.0.0" },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`(?s)is the network address.*next_hop = "10.0.0.0"`),
+			},
+			// Validate error is shown on the next hop when it is the broadcast address of cidr_block
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-routes"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "custom"
+
+  cidr_block = "10.0.0.0/24"
+
+  routes = [
+    { destination = "192.168.10.0/24", next_hop = "10.0.0.255" },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`(?s)is the broadcast address.*next_hop = "10.0.0.255"`),
+			},
+		},
+	})
+}
+
+func TestCIDRValidator(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate error is shown when cidr_block is a not a valid CIDR block
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-standard"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/245"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.140"
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`,
+				ExpectError: regexp.MustCompile("does not contain a valid CIDR block"),
+			},
+			// Validate error is shown when dhcp_start_address is a valid IP address, but not in the CIDR block
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-standard"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "100.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.140"
+
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`,
+				ExpectError: regexp.MustCompile("is not a valid IP address in the CIDR"),
+			},
+		},
+	})
+}
+
+func TestDNSServersValidator(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate error is shown when dns_servers contains a DNS server that is not a valid IP address
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-standard"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.140"
+
+  dns_servers = ["8.8.8.8", "8.8.4.4", "123.123.123.1234"]
+}
+`,
+				ExpectError: regexp.MustCompile("is not a valid IPv4 or IPv6 address"),
+			},
+			// Validate error is shown when dns_servers contains the same DNS server twice
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "test" {
+
+  name = "terraform-demo-standard"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  network_type = "standard"
+
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.140"
+
+  dns_servers = ["8.8.8.8", "8.8.4.4", "8.8.8.8"]
+}
+`,
+				ExpectError: regexp.MustCompile("unique"),
+			},
+		},
+	})
+}
+
+func TestNetworksResourceImportByNameErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Two networks sharing a name in the same datacenter
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "first" {
+  name          = "tfacc-import-duplicate"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_network" "second" {
+  name          = "tfacc-import-duplicate"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.1.0/24"
+  dhcp_start_address = "10.0.1.10"
+  dhcp_end_address   = "10.0.1.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`,
+			},
+			// Importing by an ambiguous name fails and lists the matching IDs instead of picking one
+			{
+				ResourceName:  "gpcn_network.first",
+				ImportState:   true,
+				ImportStateId: "name:tfacc-import-duplicate",
+				ExpectError:   regexp.MustCompile("2 networks named 'tfacc-import-duplicate' were found"),
+			},
+			// The datacenter-qualified form needs both a datacenter name and a name
+			{
+				ResourceName:  "gpcn_network.first",
+				ImportState:   true,
+				ImportStateId: "tfacc-import-duplicate/",
+				ExpectError:   regexp.MustCompile("must have the form"),
+			},
+		},
+	})
+}
+
+// Import blocks with an identity, both as recorded in state and as written by hand without a datacenter_id
+func TestNetworksResourceIdentityImport(t *testing.T) {
+	resourceConfig := `
+resource "gpcn_network" "identity" {
+  name          = "tfacc-identity-network"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("gpcn_network.identity", map[string]knownvalue.Check{
+						"id":            knownvalue.NotNull(),
+						"datacenter_id": knownvalue.StringExact("1ea6b709-0671-46fa-aea8-bdc8eb897d3d"),
+					}),
+				},
+				Check: testCaptureResourceAttr("gpcn_network.identity", "id", &id),
+			},
+			// Import block with the identity recorded in state
+			{
+				ResourceName:    "gpcn_network.identity",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			// Import block with an identity that only holds the ID. The datacenter_id is read from the API, so the imported
+			// identity matches the one recorded in state
+			{
+				ResourceName:           "gpcn_network.identity",
+				ImportState:            true,
+				ImportStateKind:        resource.ImportBlockWithResourceIdentity,
+				ImportStateConfigExact: true,
+				ConfigFile:             testImportByIdentityIdOnlyConfig(t, resourceConfig, "gpcn_network.identity", &id),
 			},
 		},
 	})
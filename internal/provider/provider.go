@@ -8,6 +8,7 @@ import (
 	"terraform-provider-gpcn/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -18,7 +19,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &gpcnProvider{}
+	_ provider.Provider                  = &gpcnProvider{}
+	_ provider.ProviderWithListResources = &gpcnProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -167,6 +169,7 @@ func (p *gpcnProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 
 	resp.DataSourceData = httpClient
 	resp.ResourceData = httpClient
+	resp.ListResourceData = httpClient
 	tflog.Debug(ctx, "HTTP Client successfully created. GPCN provider online")
 }
 
@@ -174,6 +177,17 @@ func (p *gpcnProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 func (p *gpcnProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewDatacenterDataSource,
+		NewDatacenterCapabilitiesDataSource,
+		NewRegionsDataSource,
+		NewCountriesDataSource,
+		NewVirtualMachinesDataSource,
+		NewNetworkDataSource,
+		NewNetworksDataSource,
+		NewVolumeDataSource,
+		NewVolumesDataSource,
+		NewVolumeTypesDataSource,
+		NewImagesDataSource,
+		NewVirtualMachineSizesDataSource,
 	}
 }
 
@@ -185,3 +199,12 @@ func (p *gpcnProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewVirtualMachinesResource,
 	}
 }
+
+// ListResources defines the list resources implemented in the provider, used by terraform query.
+func (p *gpcnProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewNetworksListResource,
+		NewVolumesListResource,
+		NewVirtualMachinesListResource,
+	}
+}
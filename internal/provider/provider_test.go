@@ -1,8 +1,18 @@
 package provider
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 // Uses environment variable configuration to populate provider values
@@ -21,3 +31,139 @@ var (
 		"gpcn": providerserver.NewProtocol6WithError(New("test")()),
 	}
 )
+
+// Checks that an attribute of every element of a list of objects passes the given check, e.g. that a data source filter only
+// returned matching results. The check returns an error describing the mismatch
+func testCheckEveryListElementAttr(name, listAttr, attr string, check func(value string) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes[listAttr+".#"])
+		if err != nil {
+			return fmt.Errorf("%s: %s is not a list", name, listAttr)
+		}
+		for i := 0; i < count; i++ {
+			key := fmt.Sprintf("%s.%d.%s", listAttr, i, attr)
+			if err := check(rs.Primary.Attributes[key]); err != nil {
+				return fmt.Errorf("%s: %s: %w", name, key, err)
+			}
+		}
+		return nil
+	}
+}
+
+// Check for testCheckEveryListElementAttr that compares against an expected value
+func testEquals(expected string) func(string) error {
+	return func(value string) error {
+		if value != expected {
+			return fmt.Errorf("expected %q, got %q", expected, value)
+		}
+		return nil
+	}
+}
+
+// Check for testCheckEveryListElementAttr that excludes a value
+func testNotEquals(excluded string) func(string) error {
+	return func(value string) error {
+		if value == excluded {
+			return fmt.Errorf("expected anything but %q", excluded)
+		}
+		return nil
+	}
+}
+
+// Check for testCheckEveryListElementAttr that verifies a value matches a regular expression
+func testMatches(pattern string) func(string) error {
+	re := regexp.MustCompile(pattern)
+	return func(value string) error {
+		if !re.MatchString(value) {
+			return fmt.Errorf("expected a value matching %q, got %q", pattern, value)
+		}
+		return nil
+	}
+}
+
+// Check for testCheckEveryListElementAttr that verifies a number lies within [low, high]
+func testInRange(low, high int64) func(string) error {
+	return func(value string) error {
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		if number < low || number > high {
+			return fmt.Errorf("expected a value between %d and %d, got %d", low, high, number)
+		}
+		return nil
+	}
+}
+
+// Builds an import ID from attributes of a resource in state, e.g. "name:%s" with "name", and a check that the import resolved
+// to that same resource. The ID func records the expected ID, so both must be used in the same ImportState step
+func testImportStateByAttrs(name, format string, attrs ...string) (resource.ImportStateIdFunc, resource.ImportStateCheckFunc) {
+	var expectedId string
+	idFunc := func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", name)
+		}
+		expectedId = rs.Primary.ID
+
+		values := make([]any, len(attrs))
+		for i, attr := range attrs {
+			value, ok := rs.Primary.Attributes[attr]
+			if !ok {
+				return "", fmt.Errorf("%s: attribute %s is not set", name, attr)
+			}
+			values[i] = value
+		}
+		return fmt.Sprintf(format, values...), nil
+	}
+	check := func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("expected 1 imported resource, got %d", len(states))
+		}
+		if states[0].ID != expectedId {
+			return fmt.Errorf("expected the import to resolve to %q, got %q", expectedId, states[0].ID)
+		}
+		return nil
+	}
+	return idFunc, check
+}
+
+// Records an attribute of a resource in state, so a later step can refer to a value that is only known once it has run
+func testCaptureResourceAttr(name, attr string, value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+		*value = rs.Primary.Attributes[attr]
+		return nil
+	}
+}
+
+// Builds the configuration for an ImportState step that imports a resource with an identity holding only its ID, as an import
+// block written by hand would, instead of the full identity recorded in state. The ID is read when the step runs, so it must
+// have been captured by an earlier step. Use with ImportStateConfigExact and ImportBlockWithResourceIdentity. Configuration files
+// must not contain a provider block, so the provider is configured from the environment only
+func testImportByIdentityIdOnlyConfig(t *testing.T, resourceConfig, name string, id *string) config.TestStepConfigFunc {
+	return func(req config.TestStepConfigRequest) string {
+		importConfig := fmt.Sprintf(`
+import {
+  to = %s
+  identity = {
+    id = %q
+  }
+}
+`, name, *id)
+
+		file := filepath.Join(t.TempDir(), fmt.Sprintf("step_%d.tf", req.StepNumber))
+		if err := os.WriteFile(file, []byte(resourceConfig+importConfig), 0o600); err != nil {
+			t.Fatalf("writing import configuration: %s", err)
+		}
+		return file
+	}
+}
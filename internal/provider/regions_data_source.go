@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"terraform-provider-gpcn/internal/datacenters"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &regionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &regionsDataSource{}
+)
+
+func NewRegionsDataSource() datasource.DataSource {
+	return &regionsDataSource{}
+}
+
+type regionsDataSource struct {
+	client *http.Client
+}
+
+type regionsDataSourceModel struct {
+	CountryName types.String `tfsdk:"country_name"`
+	Regions     types.List   `tfsdk:"regions"`
+}
+
+type regionDataResponseTF struct {
+	ID                  types.Int64  `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	CountryID           types.Int64  `tfsdk:"country_id"`
+	CountryName         types.String `tfsdk:"country_name"`
+	CountryAbbreviation types.String `tfsdk:"country_abbreviation"`
+}
+
+func (o regionDataResponseTF) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                   types.Int64Type,
+		"name":                 types.StringType,
+		"country_id":           types.Int64Type,
+		"country_name":         types.StringType,
+		"country_abbreviation": types.StringType,
+	}
+}
+
+func (d *regionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regions"
+}
+
+func (d *regionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the regions that contain GPCN datacenters. Use this data source to list the locations available for deployment, optionally within a single country.",
+		Attributes: map[string]schema.Attribute{
+			"country_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter regions by country name (e.g., 'United States', 'Canada').",
+			},
+			"regions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of regions matching the specified filter criteria.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Numeric identifier of the region.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the region. Use this value for the 'region_name' filter of gpcn_datacenters.",
+						},
+						"country_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Numeric identifier of the country the region belongs to.",
+						},
+						"country_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the country the region belongs to.",
+						},
+						"country_abbreviation": schema.StringAttribute{
+							Computed:    true,
+							Description: "Two-letter country code abbreviation (e.g., 'US').",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *regionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *regionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, datacenters.LogStartingReadGPCNRegionsDataSource)
+	var state regionsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datacenterRegionResponse, err := datacenters.GetCountriesAndRegions(d.client, ctx, url.QueryEscape(state.CountryName.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			datacenters.ErrSummaryUnableGetRegions,
+			err.Error(),
+		)
+		return
+	}
+
+	regions := []regionDataResponseTF{}
+	for _, region := range datacenterRegionResponse {
+		regions = append(regions, regionDataResponseTF{
+			ID:                  types.Int64Value(region.ID),
+			Name:                types.StringValue(region.Name),
+			CountryID:           types.Int64Value(region.CountryID),
+			CountryName:         types.StringValue(region.CountryName),
+			CountryAbbreviation: types.StringValue(region.CountryAbbreviation),
+		})
+	}
+
+	state.Regions, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: regionDataResponseTF{}.AttrTypes()}, regions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, datacenters.LogSuccessfullyFinishedReadGPCNRegionsDataSource)
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestRegionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The country filter only keeps the regions of that country
+			{
+				Config: providerConfig + `
+data "gpcn_datacenter" "test" {
+  id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+}
+
+data "gpcn_regions" "all" {}
+
+data "gpcn_regions" "country" {
+  country_name = data.gpcn_datacenter.test.country_name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.gpcn_regions.all", "regions.#", testNotEquals("0")),
+					resource.TestCheckResourceAttrWith("data.gpcn_regions.country", "regions.#", testNotEquals("0")),
+					resource.TestCheckTypeSetElemAttrPair("data.gpcn_regions.country", "regions.*.name", "data.gpcn_datacenter.test", "region_name"),
+					func(s *terraform.State) error {
+						countryName := s.RootModule().Resources["data.gpcn_datacenter.test"].Primary.Attributes["country_name"]
+						return testCheckEveryListElementAttr("data.gpcn_regions.country", "regions", "country_name", testEquals(countryName))(s)
+					},
+				),
+			},
+		},
+	})
+}
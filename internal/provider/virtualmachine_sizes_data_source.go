@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"terraform-provider-gpcn/internal/virtualmachines"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &virtualMachineSizesDataSource{}
+	_ datasource.DataSourceWithConfigure = &virtualMachineSizesDataSource{}
+)
+
+func NewVirtualMachineSizesDataSource() datasource.DataSource {
+	return &virtualMachineSizesDataSource{}
+}
+
+type virtualMachineSizesDataSource struct {
+	client *http.Client
+}
+
+type virtualMachineSizesDataSourceModel struct {
+	DatacenterId types.String `tfsdk:"datacenter_id"`
+	Image        types.String `tfsdk:"image"`
+	NameRegex    types.String `tfsdk:"name_regex"`
+	MinCPU       types.Int64  `tfsdk:"min_cpu"`
+	MaxCPU       types.Int64  `tfsdk:"max_cpu"`
+	MinRAM       types.Int64  `tfsdk:"min_ram"`
+	MaxRAM       types.Int64  `tfsdk:"max_ram"`
+	Smallest     types.Bool   `tfsdk:"smallest"`
+	Sizes        types.List   `tfsdk:"sizes"`
+}
+
+func (d *virtualMachineSizesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtualmachine_sizes"
+}
+
+func (d *virtualMachineSizesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the virtual machine sizes available in a GPCN datacenter for a given image. Use this data source to select a size for gpcn_virtualmachine by its resources instead of hard-coding its name.",
+		Attributes: map[string]schema.Attribute{
+			"datacenter_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Unique identifier of the datacenter to list sizes for.",
+			},
+			"image": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the operating system image the sizes must support. Case-insensitive.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter sizes whose name matches this regular expression.",
+			},
+			"min_cpu": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Filter sizes with at least this many CPU cores.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_cpu": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Filter sizes with at most this many CPU cores.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"min_ram": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Filter sizes with at least this amount of RAM in GB. The 'ram' of each size is reported in MB.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_ram": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Filter sizes with at most this amount of RAM in GB. The 'ram' of each size is reported in MB.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"smallest": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, only the smallest matching size is returned, ordered by CPU, then RAM, then disk. Since sizes are priced by their resources, this is also the cheapest match. The lookup fails when no size matches.",
+			},
+			"sizes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of sizes matching the specified filter criteria, ordered from smallest to largest.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Unique identifier for the size configuration.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the size configuration. Use this value for the 'size' attribute of gpcn_virtualmachine.",
+						},
+						"cpu": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of CPU cores.",
+						},
+						"ram": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Amount of RAM in MB.",
+						},
+						"disk": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Disk size in GB.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *virtualMachineSizesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			virtualmachines.ErrSummaryUnexpectedConfigureType,
+			fmt.Sprintf(virtualmachines.ErrDetailExpectedHTTPClient, req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *virtualMachineSizesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, virtualmachines.LogStartingReadGPCNSizesDataSource)
+	var state virtualMachineSizesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		compiled, err := regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				virtualmachines.ErrSummaryInvalidFilter,
+				fmt.Sprintf(virtualmachines.ErrDetailInvalidNameRegex, state.NameRegex.ValueString(), err.Error()),
+			)
+			return
+		}
+		nameRegex = compiled
+	}
+
+	// Sizes are offered per image, so resolve the image first
+	imageId, _, err := virtualmachines.GetVirtualMachineImageId(d.client, ctx, state.DatacenterId.ValueString(), state.Image.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			virtualmachines.ErrSummaryErrorVerifyingImage,
+			fmt.Sprintf(virtualmachines.ErrDetailImageVerificationFailed, state.Image.ValueString(), state.DatacenterId.ValueString())+": "+err.Error(),
+		)
+		return
+	}
+
+	allSizes, err := virtualmachines.ListVirtualMachineSizes(d.client, ctx, imageId, state.DatacenterId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			virtualmachines.ErrSummaryUnableToListSizes,
+			err.Error(),
+		)
+		return
+	}
+
+	sizes := []virtualmachines.VirtualMachineSizesDataResponseTF{}
+	for _, size := range allSizes {
+		if nameRegex != nil && !nameRegex.MatchString(size.Name.ValueString()) {
+			continue
+		}
+		if !state.MinCPU.IsNull() && size.CPU.ValueInt64() < state.MinCPU.ValueInt64() {
+			continue
+		}
+		if !state.MaxCPU.IsNull() && size.CPU.ValueInt64() > state.MaxCPU.ValueInt64() {
+			continue
+		}
+		// The RAM filters are in GB, while the catalog reports RAM in MB
+		if !state.MinRAM.IsNull() && size.RAM.ValueInt64() < state.MinRAM.ValueInt64()*virtualmachines.MB_PER_GB {
+			continue
+		}
+		if !state.MaxRAM.IsNull() && size.RAM.ValueInt64() > state.MaxRAM.ValueInt64()*virtualmachines.MB_PER_GB {
+			continue
+		}
+		sizes = append(sizes, size)
+	}
+	slices.SortStableFunc(sizes, virtualmachines.CompareVirtualMachineSizes)
+
+	if state.Smallest.ValueBool() {
+		if len(sizes) < 1 {
+			var names []string
+			for _, size := range allSizes {
+				names = append(names, size.Name.ValueString())
+			}
+			resp.Diagnostics.AddError(
+				virtualmachines.ErrSummaryNoMatchingSize,
+				fmt.Sprintf(virtualmachines.ErrDetailNoSizeMatchesFilters, state.Image.ValueString(), state.DatacenterId.ValueString(), strings.Join(names, ", ")),
+			)
+			return
+		}
+		sizes = sizes[:1]
+	}
+
+	state.Sizes, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: virtualmachines.VirtualMachineSizesDataResponseTF{}.AttrTypes()}, sizes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, virtualmachines.LogSuccessfullyFinishedReadGPCNSizesDataSource)
+}
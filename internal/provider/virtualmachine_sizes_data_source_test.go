@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"math"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestVirtualMachineSizesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Each resource filter only keeps sizes within its bounds, and smallest keeps a single size. The RAM filters are in GB,
+			// while sizes report their RAM in MB
+			{
+				Config: providerConfig + `
+data "gpcn_virtualmachine_sizes" "all" {
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  image         = "Alma Linux 8.x"
+}
+
+data "gpcn_virtualmachine_sizes" "small" {
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  image         = "Alma Linux 8.x"
+  max_cpu       = 2
+  max_ram       = 4
+}
+
+data "gpcn_virtualmachine_sizes" "large" {
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  image         = "Alma Linux 8.x"
+  min_cpu       = 2
+  min_ram       = 4
+}
+
+data "gpcn_virtualmachine_sizes" "smallest_large" {
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  image         = "Alma Linux 8.x"
+  min_cpu       = 2
+  min_ram       = 4
+  smallest      = true
+}
+
+data "gpcn_virtualmachine_sizes" "micro" {
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  image         = "Alma Linux 8.x"
+  name_regex    = "^Micro$"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.gpcn_virtualmachine_sizes.smallest_large", tfjsonpath.New("sizes"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue("data.gpcn_virtualmachine_sizes.micro", tfjsonpath.New("sizes"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue("data.gpcn_virtualmachine_sizes.micro", tfjsonpath.New("sizes").AtSliceIndex(0).AtMapKey("name"), knownvalue.StringExact("Micro")),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.gpcn_virtualmachine_sizes.all", "sizes.*", map[string]string{"name": "Micro"}),
+					testCheckEveryListElementAttr("data.gpcn_virtualmachine_sizes.small", "sizes", "cpu", testInRange(0, 2)),
+					testCheckEveryListElementAttr("data.gpcn_virtualmachine_sizes.small", "sizes", "ram", testInRange(0, 4*1024)),
+					testCheckEveryListElementAttr("data.gpcn_virtualmachine_sizes.large", "sizes", "cpu", testInRange(2, math.MaxInt64)),
+					testCheckEveryListElementAttr("data.gpcn_virtualmachine_sizes.large", "sizes", "ram", testInRange(4*1024, math.MaxInt64)),
+					testCheckEveryListElementAttr("data.gpcn_virtualmachine_sizes.smallest_large", "sizes", "cpu", testInRange(2, math.MaxInt64)),
+					// The smallest size is the first of the filtered sizes, which are sorted by CPU, RAM, and disk
+					resource.TestCheckResourceAttrPair("data.gpcn_virtualmachine_sizes.smallest_large", "sizes.0.name", "data.gpcn_virtualmachine_sizes.large", "sizes.0.name"),
+				),
+			},
+			// smallest fails when no size satisfies the filters
+			{
+				Config: providerConfig + `
+data "gpcn_virtualmachine_sizes" "impossible" {
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  image         = "Alma Linux 8.x"
+  min_cpu       = 8
+  max_cpu       = 2
+  smallest      = true
+}
+`,
+				ExpectError: regexp.MustCompile("No matching GPCN Virtual Machine size"),
+			},
+		},
+	})
+}
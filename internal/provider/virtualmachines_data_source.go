@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"terraform-provider-gpcn/internal/virtualmachines"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &virtualMachinesDataSource{}
+	_ datasource.DataSourceWithConfigure = &virtualMachinesDataSource{}
+)
+
+func NewVirtualMachinesDataSource() datasource.DataSource {
+	return &virtualMachinesDataSource{}
+}
+
+type virtualMachinesDataSource struct {
+	client *http.Client
+}
+
+type virtualMachinesDataSourceModel struct {
+	DatacenterId    types.String `tfsdk:"datacenter_id"`
+	NameRegex       types.String `tfsdk:"name_regex"`
+	Status          types.String `tfsdk:"status"`
+	Image           types.String `tfsdk:"image"`
+	Size            types.String `tfsdk:"size"`
+	VirtualMachines types.List   `tfsdk:"virtual_machines"`
+}
+
+func (d *virtualMachinesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtualmachines"
+}
+
+func (d *virtualMachinesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves information about existing GPCN virtual machines. Use this data source to find virtual machines by datacenter, name, status, image, or size, including ones not managed by this configuration.",
+		Attributes: map[string]schema.Attribute{
+			"datacenter_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter virtual machines by the unique identifier of their datacenter.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter virtual machines whose name matches this regular expression.",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter virtual machines by lifecycle status (e.g., 'Running', 'Shutoff'). Case-insensitive.",
+			},
+			"image": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter virtual machines by operating system image name. Case-insensitive.",
+			},
+			"size": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter virtual machines by size name. Case-insensitive.",
+			},
+			"virtual_machines": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of virtual machines matching the specified filter criteria.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: virtualMachineDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Attributes describing a single virtual machine. Kept separate so other data sources can expose the same shape
+func virtualMachineDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Unique identifier for the virtual machine in UUID format.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Human-readable name of the virtual machine.",
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "Current lifecycle status of the virtual machine (e.g., 'Running', 'Shutoff').",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timestamp when the virtual machine was created in ISO-8601 format.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timestamp when the virtual machine was last updated in ISO-8601 format.",
+		},
+		"size_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Internal identifier for the size configuration of the virtual machine.",
+		},
+		"size": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the size configuration of the virtual machine.",
+		},
+		"cpu": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Number of CPU cores.",
+		},
+		"ram": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Amount of RAM in GB.",
+		},
+		"disk": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Base disk size in GB.",
+		},
+		"image": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the operating system image.",
+		},
+		"username": schema.StringAttribute{
+			Computed:    true,
+			Description: "Default login username for the virtual machine.",
+		},
+		"datacenter_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Unique identifier of the datacenter where the virtual machine is located.",
+		},
+		"datacenter": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the datacenter where the virtual machine is located.",
+		},
+		"region_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Numeric identifier of the region where the virtual machine is located.",
+		},
+		"region": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the region where the virtual machine is located.",
+		},
+		"country": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the country where the virtual machine is located.",
+		},
+	}
+}
+
+func (d *virtualMachinesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			virtualmachines.ErrSummaryUnexpectedConfigureType,
+			fmt.Sprintf(virtualmachines.ErrDetailExpectedHTTPClient, req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *virtualMachinesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, virtualmachines.LogStartingReadGPCNVirtualMachinesDataSource)
+	var state virtualMachinesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		compiled, err := regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				virtualmachines.ErrSummaryInvalidFilter,
+				fmt.Sprintf(virtualmachines.ErrDetailInvalidNameRegex, state.NameRegex.ValueString(), err.Error()),
+			)
+			return
+		}
+		nameRegex = compiled
+	}
+
+	listVirtualMachinesResponse, err := virtualmachines.ListVirtualMachines(d.client, ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			virtualmachines.ErrSummaryUnableToListVMs,
+			err.Error(),
+		)
+		return
+	}
+
+	virtualMachineList := []virtualmachines.VirtualMachineDataResponseTF{}
+	for _, data := range listVirtualMachinesResponse {
+		virtualMachine := virtualmachines.MapVirtualMachineDataToTF(data)
+		if !state.DatacenterId.IsNull() && virtualMachine.DatacenterId.ValueString() != state.DatacenterId.ValueString() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(virtualMachine.Name.ValueString()) {
+			continue
+		}
+		if !state.Status.IsNull() && !strings.EqualFold(virtualMachine.Status.ValueString(), state.Status.ValueString()) {
+			continue
+		}
+		if !state.Image.IsNull() && !strings.EqualFold(virtualMachine.Image.ValueString(), state.Image.ValueString()) {
+			continue
+		}
+		if !state.Size.IsNull() && !strings.EqualFold(virtualMachine.Configuration.ValueString(), state.Size.ValueString()) {
+			continue
+		}
+		virtualMachineList = append(virtualMachineList, virtualMachine)
+	}
+
+	state.VirtualMachines, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: virtualmachines.VirtualMachineDataResponseTF{}.AttrTypes()}, virtualMachineList)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, virtualmachines.LogSuccessfullyFinishedReadGPCNVirtualMachinesDataSource)
+}
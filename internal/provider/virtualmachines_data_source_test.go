@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestVirtualMachinesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Two running virtual machines, looked up with filters that match both, one, or none of them
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "tfacc-ds-vm-network"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_virtualmachine" "web" {
+  name          = "tfacc-ds-web"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  size          = "Micro"
+  image         = "Alma Linux 8.x"
+  allocate_public_ip = false
+  network_ids   = [gpcn_network.vm_network.id]
+}
+
+resource "gpcn_virtualmachine" "db" {
+  name          = "tfacc-ds-db"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  size          = "Micro"
+  image         = "Alma Linux 8.x"
+  allocate_public_ip = false
+  network_ids   = [gpcn_network.vm_network.id]
+}
+
+data "gpcn_virtualmachines" "all" {
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  name_regex    = "^tfacc-ds-"
+  depends_on    = [gpcn_virtualmachine.web, gpcn_virtualmachine.db]
+}
+
+data "gpcn_virtualmachines" "web" {
+  name_regex = "^tfacc-ds-web$"
+  depends_on = [gpcn_virtualmachine.web, gpcn_virtualmachine.db]
+}
+
+data "gpcn_virtualmachines" "running" {
+  name_regex = "^tfacc-ds-"
+  status     = "running"
+  depends_on = [gpcn_virtualmachine.web, gpcn_virtualmachine.db]
+}
+
+data "gpcn_virtualmachines" "shutoff" {
+  name_regex = "^tfacc-ds-"
+  status     = "Shutoff"
+  depends_on = [gpcn_virtualmachine.web, gpcn_virtualmachine.db]
+}
+
+data "gpcn_virtualmachines" "other_image" {
+  name_regex = "^tfacc-ds-"
+  image      = "Ubuntu 24.04"
+  depends_on = [gpcn_virtualmachine.web, gpcn_virtualmachine.db]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.gpcn_virtualmachines.all", tfjsonpath.New("virtual_machines"), knownvalue.ListSizeExact(2)),
+					// The name regex narrows the results down to a single virtual machine
+					statecheck.ExpectKnownValue("data.gpcn_virtualmachines.web", tfjsonpath.New("virtual_machines"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue("data.gpcn_virtualmachines.web", tfjsonpath.New("virtual_machines").AtSliceIndex(0).AtMapKey("name"), knownvalue.StringExact("tfacc-ds-web")),
+					// The status filter is case-insensitive, and excludes virtual machines in another status
+					statecheck.ExpectKnownValue("data.gpcn_virtualmachines.running", tfjsonpath.New("virtual_machines"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue("data.gpcn_virtualmachines.shutoff", tfjsonpath.New("virtual_machines"), knownvalue.ListSizeExact(0)),
+					statecheck.ExpectKnownValue("data.gpcn_virtualmachines.other_image", tfjsonpath.New("virtual_machines"), knownvalue.ListSizeExact(0)),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckEveryListElementAttr("data.gpcn_virtualmachines.all", "virtual_machines", "datacenter_id", testEquals("1ea6b709-0671-46fa-aea8-bdc8eb897d3d")),
+					testCheckEveryListElementAttr("data.gpcn_virtualmachines.running", "virtual_machines", "status", testEquals("Running")),
+					resource.TestCheckResourceAttrPair("data.gpcn_virtualmachines.web", "virtual_machines.0.id", "gpcn_virtualmachine.web", "id"),
+				),
+			},
+		},
+	})
+}
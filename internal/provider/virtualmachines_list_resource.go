@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"terraform-provider-gpcn/internal/virtualmachines"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &virtualMachinesListResource{}
+	_ list.ListResourceWithConfigure = &virtualMachinesListResource{}
+)
+
+// NewVirtualMachinesListResource is a helper function to simplify the provider implementation.
+func NewVirtualMachinesListResource() list.ListResource {
+	return &virtualMachinesListResource{}
+}
+
+// virtualMachinesListResource is the list resource implementation, used by terraform query to discover existing virtual machines.
+type virtualMachinesListResource struct {
+	client *http.Client
+}
+
+type virtualMachinesListResourceModel struct {
+	DatacenterId types.String `tfsdk:"datacenter_id"`
+	NameRegex    types.String `tfsdk:"name_regex"`
+	Status       types.String `tfsdk:"status"`
+}
+
+// Metadata returns the resource type name. It must match the managed resource.
+func (r *virtualMachinesListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtualmachine"
+}
+
+// ListResourceConfigSchema defines the filters accepted by list blocks.
+func (r *virtualMachinesListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists existing GPCN virtual machines, including ones not managed by Terraform, so they can be imported",
+		Attributes: map[string]schema.Attribute{
+			"datacenter_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list virtual machines in the datacenter with this unique identifier",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list virtual machines whose name matches this regular expression",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list virtual machines with this status (e.g., 'Running', 'Stopped'). Case-insensitive",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *virtualMachinesListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			virtualmachines.ErrSummaryUnexpectedConfigureType,
+			fmt.Sprintf(virtualmachines.ErrDetailExpectedHTTPClient, req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// List streams every virtual machine matching the filters, with its identity and, when requested, its attributes.
+func (r *virtualMachinesListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Info(ctx, virtualmachines.LogStartingListGPCNVirtualMachines)
+	var config virtualMachinesListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		compiled, err := regexp.Compile(config.NameRegex.ValueString())
+		if err != nil {
+			diags.AddError(
+				virtualmachines.ErrSummaryInvalidFilter,
+				fmt.Sprintf(virtualmachines.ErrDetailInvalidNameRegex, config.NameRegex.ValueString(), err.Error()),
+			)
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+		nameRegex = compiled
+	}
+
+	listVirtualMachinesResponse, err := virtualmachines.ListVirtualMachines(r.client, ctx)
+	if err != nil {
+		diags.AddError(
+			virtualmachines.ErrSummaryUnableToListVMs,
+			err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, data := range listVirtualMachinesResponse {
+			virtualMachine := virtualmachines.MapVirtualMachineDataToTF(data)
+			if !config.DatacenterId.IsNull() && virtualMachine.DatacenterId.ValueString() != config.DatacenterId.ValueString() {
+				continue
+			}
+			if nameRegex != nil && !nameRegex.MatchString(virtualMachine.Name.ValueString()) {
+				continue
+			}
+			if !config.Status.IsNull() && !strings.EqualFold(virtualMachine.Status.ValueString(), config.Status.ValueString()) {
+				continue
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = virtualMachine.Name.ValueString()
+			result.Diagnostics.Append(setResourceIdentity(ctx, result.Identity, virtualMachine.ID, virtualMachine.DatacenterId)...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, virtualmachines.MapVirtualMachineDataToModel(ctx, data))...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+		tflog.Info(ctx, virtualmachines.LogSuccessfullyFinishedListGPCNVirtualMachines)
+	}
+}
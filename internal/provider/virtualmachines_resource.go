@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"slices"
 	"strings"
@@ -15,7 +16,9 @@ import (
 	"terraform-provider-gpcn/internal/virtualmachines"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -33,9 +36,13 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &virtualMachinesResource{}
-	_ resource.ResourceWithConfigure   = &virtualMachinesResource{}
-	_ resource.ResourceWithImportState = &virtualMachinesResource{}
+	_ resource.Resource                     = &virtualMachinesResource{}
+	_ resource.ResourceWithConfigure        = &virtualMachinesResource{}
+	_ resource.ResourceWithImportState      = &virtualMachinesResource{}
+	_ resource.ResourceWithIdentity         = &virtualMachinesResource{}
+	_ resource.ResourceWithModifyPlan       = &virtualMachinesResource{}
+	_ resource.ResourceWithConfigValidators = &virtualMachinesResource{}
+	_ resource.ResourceWithUpgradeState     = &virtualMachinesResource{}
 )
 
 // NewVirtualMachinesResource is a helper function to simplify the provider implementation.
@@ -56,6 +63,7 @@ func (r *virtualMachinesResource) Metadata(_ context.Context, req resource.Metad
 // Schema defines the schema for the resource.
 func (r *virtualMachinesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Manages a virtual machine instance with configurable compute resources, networking, and storage",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -86,48 +94,25 @@ func (r *virtualMachinesResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 				Default: booldefault.StaticBool(true),
 			},
+			"allow_stop_for_update": schema.BoolAttribute{
+				Description: "Whether the virtual machine may be stopped and started again to apply an update. Attaching networks and volumes is done while the virtual machine keeps running, but resizing, detaching networks or volumes, and switching the primary network need a stop. When true, the plan warns about the stop; when false, plans that need a stop fail. Defaults to true",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"rollback_on_failure": schema.BoolAttribute{
+				Description: "Whether to delete the virtual machine when its create fails after the virtual machine itself was created, for example because it does not reach a running state or a volume cannot be attached. When false, the virtual machine is saved to the state right after it is created: a failure to reach a running state marks it as tainted so the next apply re-creates it, and volumes that could not be attached or a failed start are retried by the next apply. Defaults to false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 			"size": schema.StringAttribute{
-				Description: "Size specification defining CPU, RAM, and disk resources. Can be upgraded to a larger size without replacement, but downsizing requires replacement",
+				Description: "Size specification defining CPU, RAM, and disk resources. Checked against the sizes available for the image in the datacenter at plan time. Can be changed without replacement as long as none of CPU, RAM, or disk decreases; reducing any of them requires replacement",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					// Changing the size requires us to destroy and create a new VM if the size is smaller
-					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
-						// Get other sizes and see if this is considered a size increase or not
-						var additionalSizes []virtualmachines.VirtualMachineSizesDataResponseTF
-						req.State.GetAttribute(ctx, path.Root("additional_sizes"), &additionalSizes)
-						stateIdx := slices.IndexFunc(additionalSizes, func(size virtualmachines.VirtualMachineSizesDataResponseTF) bool {
-							return strings.EqualFold(req.StateValue.ValueString(), size.Name.ValueString())
-						})
-						// This should never return -1 but just in case...
-						if stateIdx < 0 {
-							resp.Diagnostics.AddWarning(virtualmachines.ErrSummaryUnableToCompletePlan, virtualmachines.ErrDetailSizeNoLongerAvailable)
-							resp.RequiresReplace = true
-							return
-						}
-
-						planIdx := slices.IndexFunc(additionalSizes, func(size virtualmachines.VirtualMachineSizesDataResponseTF) bool {
-							return strings.EqualFold(req.PlanValue.ValueString(), size.Name.ValueString())
-						})
-						if planIdx < 0 {
-							var names []string
-							for _, size := range additionalSizes {
-								names = append(names, size.Name.ValueString())
-							}
-							sizesFormatted := strings.Join(names, ", ")
-							resp.Diagnostics.AddError(
-								virtualmachines.ErrSummaryUnableToCompletePlan,
-								fmt.Sprintf(virtualmachines.ErrDetailSizeNotAvailableForDatacenterImage, req.PlanValue.ValueString(), sizesFormatted),
-							)
-							return
-						}
-
-						// Require a replacement if the plan CPU is smaller, or this is a size decrease
-						resp.RequiresReplace = int(additionalSizes[planIdx].CPU.ValueInt64()) < int(additionalSizes[stateIdx].CPU.ValueInt64())
-					}, "Requires a replacement if the planned size is smaller than the current size", "Requires a replacement if the plan size is smaller than the current size"),
-				},
+				// Whether a size change can be done in place is decided in ModifyPlan against the live catalog
 			},
 			"image": schema.StringAttribute{
-				Description: "Operating system image to use for the virtual machine. Changing this value requires replacing the virtual machine.  Note that not all images are available for every datacenter",
+				Description: "Operating system image to use for the virtual machine. Changing this value requires replacing the virtual machine.  Note that not all images are available for every datacenter; the image is checked against the datacenter's catalog at plan time",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					// Changing the image requires us to destroy and create a new VM
@@ -162,16 +147,21 @@ func (r *virtualMachinesResource) Schema(_ context.Context, _ resource.SchemaReq
 				Description: "Whether to allocate a public IP address for the virtual machine",
 				Required:    true,
 			},
-			"network_ids": schema.ListAttribute{
-				Description: "List of network IDs to attach to the virtual machine. Maximum of 5 networks allowed",
+			"network_ids": schema.SetAttribute{
+				Description: "Set of network IDs to attach to the virtual machine. Maximum of 5 networks allowed. The set is unordered, so the primary network is chosen with primary_network_id. When not set, the networks attached to the virtual machine are left as they are and recorded here, starting with the default network the API attaches on create. An empty set leaves them as they are as well, without recording them",
 				ElementType: types.StringType,
 				Optional:    true,
 				Computed:    true,
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(virtualmachines.MAX_NETWORKS_ATTACHED_ALLOWED),
-					listvalidator.UniqueValues(),
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(virtualmachines.MAX_NETWORKS_ATTACHED_ALLOWED),
 				},
-				Default: listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				// Planned in ModifyPlan, since an unset value follows the networks that are attached
+			},
+			"primary_network_id": schema.StringAttribute{
+				Description: "ID of the network in network_ids whose interface is the primary interface of the virtual machine. The public IP, if allocated, is attached to this interface and moves with it when the primary changes. Required when more than one network is attached; otherwise defaults to the only network, and is kept as-is while it stays in network_ids",
+				Optional:    true,
+				Computed:    true,
+				// Defaulted in ModifyPlan, since it depends on network_ids and the current primary
 			},
 			"volume_ids": schema.ListAttribute{
 				Description: "List of volume IDs to attach to the virtual machine. Maximum of 5 volumes allowed. A volume can only be attached to a single virtual machine, so this parameter will not work as expected when using Terraform's count meta-attribute",
@@ -243,6 +233,13 @@ func (r *virtualMachinesResource) Schema(_ context.Context, _ resource.SchemaReq
 	}
 }
 
+// ConfigValidators checks primary_network_id against network_ids, which attribute validators cannot do on their own.
+func (r *virtualMachinesResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		virtualmachines.PrimaryNetworkConfigValidator{},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *virtualMachinesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -295,7 +292,7 @@ func (r *virtualMachinesResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	getVirtualMachineResponse, err := virtualmachines.CreateVirtualMachine(r.client, ctx, imageId, sizeId, plan)
+	virtualMachineId, err := virtualmachines.CreateVirtualMachine(r.client, ctx, imageId, sizeId, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			virtualmachines.ErrSummaryUnableToCreateVM,
@@ -304,33 +301,116 @@ func (r *virtualMachinesResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	// Save the ID right away, so a failure in one of the steps below does not leave the virtual machine untracked
+	plan = virtualmachines.MapCreatedVirtualMachineToModel(virtualMachineId, imageId, sizeId, plan)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = setResourceIdentity(ctx, resp.Identity, plan.ID, plan.DatacenterId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf(virtualmachines.LogSavedPartialStateGPCNVirtualMachine, virtualMachineId))
+
+	// Wait for the VM to actually be spun up before doing anything more
+	getVirtualMachineResponse, err := virtualmachines.PollForVirtualMachineStatus(r.client, ctx, virtualMachineId, []string{virtualmachines.Running, virtualmachines.Shutoff}, virtualmachines.DEFAULT_NETWORK_TIMEOUT_SECONDS)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			virtualmachines.ErrSummaryRetrievingVMInfoFailed,
+			err.Error(),
+		)
+		r.failCreate(ctx, resp, plan)
+		return
+	}
+	tflog.Info(ctx, virtualmachines.LogSuccessfullyProcessedVMCreate)
+
 	plan = virtualmachines.MapVirtualMachineResponseToModel(ctx, getVirtualMachineResponse, images, sizes, plan)
 
+	// The primary is only left to the API when there is a single network, or a default network is attached. The attached
+	// networks are recorded as well when network_ids is not configured
+	if plan.PrimaryNetworkId.IsNull() || plan.NetworkIds.IsNull() {
+		networkInterfaces, err := networks.GetNetworkInterfaces(r.client, ctx, virtualMachineId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				virtualmachines.ErrSummaryErrorRetrievingNetworkIfaces,
+				fmt.Sprintf(virtualmachines.ErrDetailNetworkInterfacesForNewVM, virtualMachineId)+": "+err.Error(),
+			)
+			r.failCreate(ctx, resp, plan)
+			return
+		}
+		if plan.PrimaryNetworkId.IsNull() {
+			primaryNetworkId, _ := virtualmachines.PrimaryNetworkOfInterfaces(networkInterfaces)
+			plan.PrimaryNetworkId = types.StringValue(primaryNetworkId)
+		}
+		if plan.NetworkIds.IsNull() {
+			plan.NetworkIds, diags = types.SetValueFrom(ctx, types.StringType, virtualmachines.NetworksOfInterfaces(networkInterfaces))
+			resp.Diagnostics.Append(diags...)
+		}
+	}
+
+	// Steps that fail from here on are retried by the next apply, unless the create is rolled back
+	var pending virtualmachines.PendingCreateSteps
+
 	// Attach each volume
 	if !plan.VolumeIds.IsNull() {
 		var volumeIds []string
 		plan.VolumeIds.ElementsAs(ctx, &volumeIds, true)
-		for _, volumeId := range volumeIds {
-			err = volumes.AddVolumeToVirtualMachine(r.client, ctx, plan.ID.ValueString(), volumeId)
-			if err != nil {
-				resp.Diagnostics.AddWarning(
-					virtualmachines.WarnSummaryAttachingVolumeFailed,
-					fmt.Sprintf(virtualmachines.WarnDetailAttachingVolumeWithIDFailed, volumeId)+": "+err.Error(),
+		failures := virtualmachines.UpdateVolumes(r.client, ctx, virtualMachineId, nil, volumeIds)
+		for _, volumeId := range slices.Sorted(maps.Keys(failures)) {
+			if plan.RollbackOnFailure.ValueBool() {
+				resp.Diagnostics.AddError(
+					virtualmachines.ErrSummaryErrorUpdatingVolumes,
+					fmt.Sprintf(virtualmachines.WarnDetailAttachingVolumeWithIDFailed, volumeId)+": "+failures[volumeId].Error(),
 				)
+				continue
 			}
+			resp.Diagnostics.AddWarning(
+				virtualmachines.WarnSummaryAttachingVolumeFailed,
+				fmt.Sprintf(virtualmachines.WarnDetailAttachingVolumeWithIDFailed, volumeId)+": "+failures[volumeId].Error(),
+			)
+			pending.AttachVolumeIds = append(pending.AttachVolumeIds, volumeId)
+		}
+		if resp.Diagnostics.HasError() {
+			r.failCreate(ctx, resp, plan)
+			return
 		}
 	}
 
 	// Once finished, start the virtual machine. It may already be started, in which case this will be a quick call
-	err = virtualmachines.StartVirtualMachine(r.client, ctx, plan.ID.ValueString(), plan.WaitForStartup.ValueBool())
+	err = virtualmachines.StartVirtualMachine(r.client, ctx, virtualMachineId, plan.WaitForStartup.ValueBool())
 	if err != nil {
+		if plan.RollbackOnFailure.ValueBool() {
+			resp.Diagnostics.AddError(
+				virtualmachines.ErrSummaryUnableToStartVM,
+				fmt.Sprintf(virtualmachines.ErrDetailStartingVM, virtualMachineId)+": "+err.Error(),
+			)
+			r.failCreate(ctx, resp, plan)
+			return
+		}
 		resp.Diagnostics.AddWarning(
 			virtualmachines.WarnSummaryUnableToStartVM,
-			fmt.Sprintf(virtualmachines.ErrDetailStartingVM, plan.ID.ValueString())+": "+err.Error(),
+			fmt.Sprintf(virtualmachines.ErrDetailStartingVM, virtualMachineId)+": "+err.Error(),
 		)
+		pending.Start = true
 	}
 	tflog.Debug(ctx, virtualmachines.LogSuccessfullyCreatedVMMayNotBeRunning)
 
+	// Record what is left for the next apply
+	if !pending.IsEmpty() {
+		resp.Diagnostics.AddWarning(
+			virtualmachines.WarnSummaryCreateStepsPending,
+			fmt.Sprintf(virtualmachines.WarnDetailCreateStepsPending, virtualMachineId, pending.Describe()),
+		)
+	}
+	diags = resp.Private.SetKey(ctx, virtualmachines.PENDING_CREATE_STEPS_PRIVATE_KEY, pending.Marshal())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -383,6 +463,25 @@ func (r *virtualMachinesResource) Read(ctx context.Context, req resource.ReadReq
 
 	state = virtualmachines.MapVirtualMachineResponseToModel(ctx, getVirtualMachineResponse, images, sizes, state)
 
+	// Attachments can be changed outside of Terraform, so they are read back to detect drift
+	networkInterfaces, err := networks.GetNetworkInterfaces(r.client, ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			virtualmachines.ErrSummaryErrorRetrievingNetworkIfaces,
+			fmt.Sprintf(virtualmachines.ErrDetailNetworkInterfacesForVM, state.ID.ValueString())+": "+err.Error(),
+		)
+		return
+	}
+	attachedVolumeIds, err := volumes.ListVolumeIdsAttachedToVirtualMachine(r.client, ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			virtualmachines.ErrSummaryErrorRetrievingVolumes,
+			fmt.Sprintf(virtualmachines.ErrDetailVolumesForVM, state.ID.ValueString())+": "+err.Error(),
+		)
+		return
+	}
+	state = virtualmachines.MapAttachmentsToModel(ctx, networkInterfaces, attachedVolumeIds, state)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -390,6 +489,12 @@ func (r *virtualMachinesResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	diags = setResourceIdentity(ctx, resp.Identity, state.ID, state.DatacenterId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, virtualmachines.LogSuccessfullyFinishedReadGPCNVirtualMachine)
 }
 
@@ -411,6 +516,13 @@ func (r *virtualMachinesResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	// Steps of an unfinished create are run again as part of this update
+	pending, diags := getPendingCreateSteps(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Validate we aren't removing every network
 	err := virtualmachines.ValidateAllNetworksAreNotRemoved(state.NetworkIds, plan.NetworkIds)
 	if err != nil {
@@ -421,8 +533,10 @@ func (r *virtualMachinesResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	primaryNetworkChanged := !plan.PrimaryNetworkId.Equal(state.PrimaryNetworkId)
+
 	// Validate the prospective primary network has a valid configuration for allocatePublicIp
-	if plan.AllocatePublicIp != state.AllocatePublicIp {
+	if plan.AllocatePublicIp != state.AllocatePublicIp || (plan.AllocatePublicIp.ValueBool() && primaryNetworkChanged) {
 		// First validate the primary network type is standard
 		err := virtualmachines.ValidatePublicIpValue(r.client, ctx, plan)
 		if err != nil {
@@ -435,7 +549,15 @@ func (r *virtualMachinesResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	// Controls stopping the VM. Since this is time-expensive, we only need to do this in a few cases
-	needStopVM := determineIfVMNeedsStopped(state, plan)
+	stopReasons := virtualmachines.DescribeChangesRequiringStop(ctx, state, plan)
+	needStopVM := len(stopReasons) > 0
+	if needStopVM && !plan.AllowStopForUpdate.ValueBool() {
+		resp.Diagnostics.AddError(
+			virtualmachines.ErrSummaryUnableToUpdateVM,
+			fmt.Sprintf(virtualmachines.ErrDetailStopNotAllowed, strings.Join(stopReasons, ", ")),
+		)
+		return
+	}
 
 	// Before proceeding with update, conditionally stop the virtual machine
 	if needStopVM {
@@ -448,9 +570,16 @@ func (r *virtualMachinesResource) Update(ctx context.Context, req resource.Updat
 			return
 		}
 	}
+	// Until it is started again below, a failed update must not leave the virtual machine stopped
+	startAfterFailure := needStopVM
+	defer func() {
+		if startAfterFailure && resp.Diagnostics.HasError() {
+			r.startAfterFailedUpdate(ctx, resp, plan, pending)
+		}
+	}()
 
-	// If network ids are updated, need to call add/remove network interface
-	if !slices.Equal(plan.NetworkIds.Elements(), state.NetworkIds.Elements()) {
+	// If network ids or the primary are updated, need to call add/remove network interface and switch the primary
+	if !plan.NetworkIds.Equal(state.NetworkIds) || primaryNetworkChanged {
 		networkInterfaces, err := networks.GetNetworkInterfaces(r.client, ctx, state.ID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -463,6 +592,10 @@ func (r *virtualMachinesResource) Update(ctx context.Context, req resource.Updat
 		var oldNetworksList, newNetworksList []string
 		state.NetworkIds.ElementsAs(ctx, &oldNetworksList, true)
 		plan.NetworkIds.ElementsAs(ctx, &newNetworksList, true)
+		// An empty network_ids did not record the attached networks, so they are taken from the API instead
+		if len(oldNetworksList) == 0 {
+			oldNetworksList = virtualmachines.NetworksOfInterfaces(networkInterfaces)
+		}
 		// Validate new network interface size will not increase beyond network cap
 		err = virtualmachines.ValidateNetworkInterfacesDoesNotExceedCap(oldNetworksList, newNetworksList, networkInterfaces)
 		if err != nil {
@@ -473,12 +606,15 @@ func (r *virtualMachinesResource) Update(ctx context.Context, req resource.Updat
 			return
 		}
 
-		err = networks.UpdateNetworkInterfaces(r.client, ctx, plan.ID.ValueString(), oldNetworksList, newNetworksList, networkInterfaces)
-		if err != nil {
+		failures := networks.UpdateNetworkInterfaces(r.client, ctx, plan.ID.ValueString(), oldNetworksList, newNetworksList, plan.PrimaryNetworkId.ValueString(), plan.AllocatePublicIp.ValueBool(), networkInterfaces)
+		for _, networkId := range slices.Sorted(maps.Keys(failures)) {
 			resp.Diagnostics.AddError(
 				virtualmachines.ErrSummaryErrorUpdatingNetworkInterfaces,
-				err.Error(),
+				fmt.Sprintf(virtualmachines.ErrDetailUpdatingNetworkWithID, networkId)+": "+failures[networkId].Error(),
 			)
+		}
+		if resp.Diagnostics.HasError() {
+			r.failUpdate(ctx, resp, state)
 			return
 		}
 	}
@@ -507,9 +643,11 @@ func (r *virtualMachinesResource) Update(ctx context.Context, req resource.Updat
 			return
 		}
 		primaryNetworkInterfaceId := networkInterfaces[interfaceIdx].ID.ValueString()
+		// A primary switch above may already have moved or released the public IP
+		hasPublicIp := networkInterfaces[interfaceIdx].PublicIP.ValueString() != ""
 
 		// If this is true, allocate IP
-		if plan.AllocatePublicIp.ValueBool() {
+		if plan.AllocatePublicIp.ValueBool() && !hasPublicIp {
 			err := networks.AllocatePublicIp(r.client, ctx, plan.ID.ValueString(), primaryNetworkInterfaceId)
 			if err != nil {
 				resp.Diagnostics.AddError(
@@ -518,7 +656,7 @@ func (r *virtualMachinesResource) Update(ctx context.Context, req resource.Updat
 				)
 				return
 			}
-		} else {
+		} else if !plan.AllocatePublicIp.ValueBool() && hasPublicIp {
 			err := networks.ReleasePublicIp(r.client, ctx, plan.ID.ValueString(), primaryNetworkInterfaceId)
 			if err != nil {
 				resp.Diagnostics.AddError(
@@ -567,17 +705,24 @@ func (r *virtualMachinesResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	// If volume ids are updated, need to call attach/detach volume
-	if !slices.Equal(plan.VolumeIds.Elements(), state.VolumeIds.Elements()) {
+	if !slices.Equal(plan.VolumeIds.Elements(), state.VolumeIds.Elements()) || len(pending.AttachVolumeIds) > 0 {
 		var oldVolumesList, newVolumesList []string
 		state.VolumeIds.ElementsAs(ctx, &oldVolumesList, true)
 		plan.VolumeIds.ElementsAs(ctx, &newVolumesList, true)
+		// Volumes the create could not attach are in the state, but still need to be attached
+		oldVolumesList = slices.DeleteFunc(oldVolumesList, func(volumeId string) bool {
+			return slices.Contains(pending.AttachVolumeIds, volumeId)
+		})
 
-		err := virtualmachines.UpdateVolumes(r.client, ctx, plan.ID.ValueString(), oldVolumesList, newVolumesList)
-		if err != nil {
+		failures := virtualmachines.UpdateVolumes(r.client, ctx, plan.ID.ValueString(), oldVolumesList, newVolumesList)
+		for _, volumeId := range slices.Sorted(maps.Keys(failures)) {
 			resp.Diagnostics.AddError(
 				virtualmachines.ErrSummaryErrorUpdatingVolumes,
-				err.Error(),
+				fmt.Sprintf(virtualmachines.ErrDetailUpdatingVolumeWithID, volumeId)+": "+failures[volumeId].Error(),
 			)
+		}
+		if resp.Diagnostics.HasError() {
+			r.failUpdate(ctx, resp, state)
 			return
 		}
 	}
@@ -615,18 +760,29 @@ func (r *virtualMachinesResource) Update(ctx context.Context, req resource.Updat
 	tflog.Info(ctx, virtualmachines.LogRetrievedLatestVMInfoMappingToModel)
 	plan = virtualmachines.MapVirtualMachineResponseToModel(ctx, getVirtualMachineResponse, images, sizes, plan)
 
-	// Once finished, conditionally start the virtual machine again
-	if needStopVM {
+	// Once finished, conditionally start the virtual machine again, or for the first time if the create could not start it
+	startAfterFailure = false
+	pending = virtualmachines.PendingCreateSteps{Start: needStopVM || pending.Start}
+	if pending.Start {
 		err = virtualmachines.StartVirtualMachine(r.client, ctx, state.ID.ValueString(), plan.WaitForStartup.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddWarning(
 				virtualmachines.WarnSummaryUnableToStartVM,
 				fmt.Sprintf(virtualmachines.ErrDetailStartingVM, state.ID.ValueString())+": "+err.Error(),
 			)
+		} else {
+			pending.Start = false
 		}
 	}
 	tflog.Debug(ctx, fmt.Sprintf(virtualmachines.LogSuccessfullyUpdatedVMMayNotBeRunning, state.ID.ValueString()))
 
+	// A start that failed is retried by the next apply, everything else of an unfinished create is done now
+	diags = resp.Private.SetKey(ctx, virtualmachines.PENDING_CREATE_STEPS_PRIVATE_KEY, pending.Marshal())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -634,6 +790,12 @@ func (r *virtualMachinesResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	diags = setResourceIdentity(ctx, resp.Identity, plan.ID, plan.DatacenterId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, virtualmachines.LogSuccessfullyFinishedUpdateGPCNVirtualMachine)
 }
 
@@ -647,14 +809,27 @@ func (r *virtualMachinesResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	diags = r.deleteVirtualMachine(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, virtualmachines.LogSuccessfullyFinishedDeleteGPCNVirtualMachine)
+}
+
+// Stops, detaches, and deletes the virtual machine. Shared by Delete and by Create when it rolls back
+func (r *virtualMachinesResource) deleteVirtualMachine(ctx context.Context, state virtualmachines.ResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	// Before proceeding with delete, stop the virtual machine
 	err := virtualmachines.StopVirtualMachine(r.client, ctx, state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			virtualmachines.ErrSummaryUnableToDeleteVM,
 			fmt.Sprintf(virtualmachines.ErrDetailStoppingVM, state.ID.ValueString())+": "+err.Error(),
 		)
-		return
+		return diags
 	}
 
 	// Before deleting, detach any network interfaces first
@@ -662,11 +837,11 @@ func (r *virtualMachinesResource) Delete(ctx context.Context, req resource.Delet
 		networkInterfaces, err := networks.GetNetworkInterfaces(r.client, ctx, state.ID.ValueString())
 
 		if err != nil {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				virtualmachines.ErrSummaryErrorRetrievingNetworkIfaces,
 				fmt.Sprintf(virtualmachines.ErrDetailNetworkInterfacesForVM, state.ID.ValueString())+": "+err.Error(),
 			)
-			return
+			return diags
 		}
 
 		for _, adapter := range networkInterfaces {
@@ -674,7 +849,7 @@ func (r *virtualMachinesResource) Delete(ctx context.Context, req resource.Delet
 			if adapter.IsPrimary.ValueInt64() != 1 {
 				err = networks.RemoveNetworkInterface(r.client, ctx, state.ID.ValueString(), adapter.ID.ValueString())
 				if err != nil {
-					resp.Diagnostics.AddWarning(
+					diags.AddWarning(
 						virtualmachines.WarnSummaryRemovingNetworkInterfaceFailed,
 						fmt.Sprintf(virtualmachines.WarnDetailRemovingNetworkInterfaceWithIDFailed, adapter.ID.ValueString())+": "+err.Error(),
 					)
@@ -690,7 +865,7 @@ func (r *virtualMachinesResource) Delete(ctx context.Context, req resource.Delet
 		for _, volumeId := range volumeIds {
 			err := volumes.RemoveVolumeFromVirtualMachine(r.client, ctx, volumeId)
 			if err != nil {
-				resp.Diagnostics.AddWarning(
+				diags.AddWarning(
 					virtualmachines.WarnSummaryRemovingVolumeFailed,
 					fmt.Sprintf(virtualmachines.WarnDetailRemovingVolumeWithIDFailed, volumeId)+": "+err.Error(),
 				)
@@ -700,21 +875,21 @@ func (r *virtualMachinesResource) Delete(ctx context.Context, req resource.Delet
 
 	request, err := http.NewRequest("DELETE", virtualmachines.BASE_URL_V1+state.ID.ValueString(), nil)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			virtualmachines.ErrSummaryUnableToCreateDeleteRequest,
 			err.Error(),
 		)
-		return
+		return diags
 	}
 	tflog.Info(ctx, virtualmachines.LogConstructedDeleteGPCNVirtualMachineRequest)
 
 	response, err := r.client.Do(request)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			virtualmachines.ErrSummaryUnableToDeleteVM,
 			fmt.Sprintf(virtualmachines.ErrDetailUnableToDeleteVMWithID, state.ID.ValueString())+": "+err.Error(),
 		)
-		return
+		return diags
 	}
 	tflog.Info(ctx, virtualmachines.LogIssuedDeleteGPCNVirtualMachineJob)
 	defer response.Body.Close()
@@ -722,52 +897,506 @@ func (r *virtualMachinesResource) Delete(ctx context.Context, req resource.Delet
 	// Read the response body and process it as deleteVirtualMachineResponse
 	body, err := io.ReadAll(response.Body)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			virtualmachines.ErrSummaryErrorReadingDeleteBody,
 			err.Error(),
 		)
-		return
+		return diags
 	}
 
 	var deleteVirtualMachineResponse client.JobStatusSingularResponse
 	err = json.Unmarshal(body, &deleteVirtualMachineResponse)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			virtualmachines.ErrSummaryErrorUnmarshalingDelete,
 			fmt.Sprintf(virtualmachines.ErrDetailUnmarshalingDeleteWithID, state.ID.ValueString())+": "+err.Error(),
 		)
-		return
+		return diags
 	}
 
 	_, err = client.PerformLongPolling(r.client, ctx, "Delete GPCN Virtual Machine", deleteVirtualMachineResponse.Data.JobID)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			virtualmachines.ErrSummaryEncounteredErrorGettingJobInfo,
 			virtualmachines.ErrDetailJobInfoCheckDashboard+": "+err.Error(),
 		)
+		return diags
+	}
+
+	return diags
+}
+
+// Records the networks, volumes, and public IP that are attached after an update failed part of the way. The errors are already
+// added; the framework would otherwise keep the prior state, which misses the attachments that did succeed until the next refresh
+func (r *virtualMachinesResource) failUpdate(ctx context.Context, resp *resource.UpdateResponse, state virtualmachines.ResourceModel) {
+	networkInterfaces, err := networks.GetNetworkInterfaces(r.client, ctx, state.ID.ValueString())
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf(virtualmachines.LogUnableToRecordAttachmentsAfterFailedUpdate, state.ID.ValueString(), err.Error()))
+		return
+	}
+	attachedVolumeIds, err := volumes.ListVolumeIdsAttachedToVirtualMachine(r.client, ctx, state.ID.ValueString())
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf(virtualmachines.LogUnableToRecordAttachmentsAfterFailedUpdate, state.ID.ValueString(), err.Error()))
 		return
 	}
 
-	tflog.Info(ctx, virtualmachines.LogSuccessfullyFinishedDeleteGPCNVirtualMachine)
+	state = virtualmachines.MapAttachmentsToModel(ctx, networkInterfaces, attachedVolumeIds, state)
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, fmt.Sprintf(virtualmachines.LogRecordedAttachmentsAfterFailedUpdate, state.ID.ValueString()))
 }
 
+// Starts the virtual machine again after an update failed once it was stopped. The errors are already added; when the start fails
+// as well, it is recorded in private state so the next apply starts the virtual machine
+func (r *virtualMachinesResource) startAfterFailedUpdate(ctx context.Context, resp *resource.UpdateResponse, plan virtualmachines.ResourceModel, pending virtualmachines.PendingCreateSteps) {
+	tflog.Info(ctx, fmt.Sprintf(virtualmachines.LogStartingVMAfterFailedUpdate, plan.ID.ValueString()))
+	err := virtualmachines.StartVirtualMachine(r.client, ctx, plan.ID.ValueString(), plan.WaitForStartup.ValueBool())
+	pending.Start = err != nil
+	if err != nil {
+		resp.Diagnostics.AddError(
+			virtualmachines.ErrSummaryUnableToStartVM,
+			fmt.Sprintf(virtualmachines.ErrDetailStartingVMAfterFailedUpdate, plan.ID.ValueString())+": "+err.Error(),
+		)
+	}
+
+	diags := resp.Private.SetKey(ctx, virtualmachines.PENDING_CREATE_STEPS_PRIVATE_KEY, pending.Marshal())
+	resp.Diagnostics.Append(diags...)
+}
+
+// Reports that the create failed after the virtual machine was saved to the state. The errors are already added; Terraform then
+// marks the virtual machine as tainted, so the next apply re-creates it. With rollback_on_failure it is deleted right away instead
+func (r *virtualMachinesResource) failCreate(ctx context.Context, resp *resource.CreateResponse, state virtualmachines.ResourceModel) {
+	if !state.RollbackOnFailure.ValueBool() {
+		resp.Diagnostics.AddError(
+			virtualmachines.ErrSummaryVMCreateNotFinished,
+			fmt.Sprintf(virtualmachines.ErrDetailCreateNotFinished, state.ID.ValueString()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf(virtualmachines.LogRollingBackCreateGPCNVirtualMachine, state.ID.ValueString()))
+	diags := r.deleteVirtualMachine(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		resp.Diagnostics.AddError(
+			virtualmachines.ErrSummaryVMCreateNotFinished,
+			fmt.Sprintf(virtualmachines.ErrDetailRollbackFailed, state.ID.ValueString()),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.AddError(
+		virtualmachines.ErrSummaryVMCreateRolledBack,
+		fmt.Sprintf(virtualmachines.ErrDetailCreateRolledBack, state.ID.ValueString()),
+	)
+}
+
+// ModifyPlan defaults the primary network, checks the image and size against the datacenter's catalog so invalid values fail at
+// plan time instead of during apply, reports updates that need the virtual machine to be stopped, and plans an update to
+// finish a create that did not complete.
+func (r *virtualMachinesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when the virtual machine is being destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	tflog.Info(ctx, virtualmachines.LogStartingModifyPlanGPCNVirtualMachine)
+	var plan virtualmachines.ResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.NetworkIds = r.planNetworkIds(ctx, req, resp, plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.planPrimaryNetwork(ctx, req, resp, plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.planCatalog(ctx, req, resp, plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		r.planStop(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		r.planPendingCreateSteps(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Info(ctx, virtualmachines.LogSuccessfullyFinishedModifyPlanGPCNVirtualMachine)
+}
+
+// Checks the image and size against the datacenter's catalog, and plans how a size change is applied
+func (r *virtualMachinesResource) planCatalog(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, plan virtualmachines.ResourceModel) {
+	// Values coming from other resources can only be validated during apply
+	if plan.DatacenterId.IsUnknown() || plan.Image.IsUnknown() || plan.Size.IsUnknown() {
+		return
+	}
+
+	// The catalog was already checked when the current values were applied
+	if !req.State.Raw.IsNull() {
+		var state virtualmachines.ResourceModel
+		diags := req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.DatacenterId.Equal(state.DatacenterId) && strings.EqualFold(plan.Image.ValueString(), state.Image.ValueString()) && strings.EqualFold(plan.Size.ValueString(), state.Size.ValueString()) {
+			return
+		}
+	}
+
+	imageId, _, err := virtualmachines.GetVirtualMachineImageId(r.client, ctx, plan.DatacenterId.ValueString(), plan.Image.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("image"),
+			virtualmachines.ErrSummaryErrorVerifyingImage,
+			fmt.Sprintf(virtualmachines.ErrDetailImageVerificationFailed, plan.Image.ValueString(), plan.DatacenterId.ValueString())+": "+err.Error(),
+		)
+		return
+	}
+
+	sizeId, sizes, err := virtualmachines.GetVirtualMachineSizeId(r.client, ctx, imageId, plan.DatacenterId.ValueString(), plan.Size.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("size"),
+			virtualmachines.ErrSummaryErrorVerifyingSize,
+			fmt.Sprintf(virtualmachines.ErrDetailSizeVerificationFailed, plan.Size.ValueString(), plan.DatacenterId.ValueString())+": "+err.Error(),
+		)
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		r.planResize(ctx, req, resp, plan, sizes)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Both identifiers are known now, so they do not need to show as unknown in the plan
+	diags := resp.Plan.SetAttribute(ctx, path.Root("image_id"), types.Int64Value(imageId))
+	resp.Diagnostics.Append(diags...)
+	diags = resp.Plan.SetAttribute(ctx, path.Root("size_id"), types.Int64Value(sizeId))
+	resp.Diagnostics.Append(diags...)
+}
+
+// Reports the changes that need the virtual machine to be stopped, and fails the plan when allow_stop_for_update is false.
+// A virtual machine that is replaced is not stopped for an update, so nothing is reported then
+func (r *virtualMachinesResource) planStop(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if len(resp.RequiresReplace) > 0 {
+		return
+	}
+
+	// Read the plan again, since the earlier steps may have filled in computed values
+	var plan, state virtualmachines.ResourceModel
+	diags := resp.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changes to the datacenter or image replace the virtual machine through their plan modifiers
+	if !plan.DatacenterId.Equal(state.DatacenterId) || !plan.Image.Equal(state.Image) {
+		return
+	}
+
+	stopReasons := virtualmachines.DescribeChangesRequiringStop(ctx, state, plan)
+	if len(stopReasons) == 0 || plan.AllowStopForUpdate.IsUnknown() {
+		return
+	}
+	if !plan.AllowStopForUpdate.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allow_stop_for_update"),
+			virtualmachines.ErrSummaryUnableToCompletePlan,
+			fmt.Sprintf(virtualmachines.ErrDetailStopNotAllowed, strings.Join(stopReasons, ", ")),
+		)
+		return
+	}
+	resp.Diagnostics.AddWarning(
+		virtualmachines.WarnSummaryUpdateStopsVM,
+		fmt.Sprintf(virtualmachines.WarnDetailUpdateStopsVM, strings.Join(stopReasons, ", ")),
+	)
+}
+
+// Plans an update when a previous create left steps unfinished, since the plan may otherwise have no changes at all. A virtual
+// machine that is replaced does not need them anymore
+func (r *virtualMachinesResource) planPendingCreateSteps(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if len(resp.RequiresReplace) > 0 {
+		return
+	}
+
+	pending, diags := getPendingCreateSteps(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || pending.IsEmpty() {
+		return
+	}
+
+	var id types.String
+	diags = req.State.GetAttribute(ctx, path.Root("id"), &id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf(virtualmachines.LogResumingCreateGPCNVirtualMachine, id.ValueString(), pending.Describe()))
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("last_updated"), types.StringUnknown())
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.AddWarning(
+		virtualmachines.WarnSummaryResumingCreate,
+		fmt.Sprintf(virtualmachines.WarnDetailResumingCreate, id.ValueString(), pending.Describe()),
+	)
+}
+
+// Decides whether a size change can be done in place by comparing the CPU, RAM, and disk of the current and planned sizes.
+// Any resource that shrinks forces a replacement, and the reason is reported either way
+func (r *virtualMachinesResource) planResize(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, plan virtualmachines.ResourceModel, sizes []virtualmachines.VirtualMachineSizesDataResponseTF) {
+	var state virtualmachines.ResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new image or datacenter already replaces the virtual machine, so there is no resize to plan
+	if strings.EqualFold(plan.Size.ValueString(), state.Size.ValueString()) || !plan.DatacenterId.Equal(state.DatacenterId) || !strings.EqualFold(plan.Image.ValueString(), state.Image.ValueString()) {
+		return
+	}
+
+	plannedIdx := slices.IndexFunc(sizes, func(size virtualmachines.VirtualMachineSizesDataResponseTF) bool {
+		return strings.EqualFold(plan.Size.ValueString(), size.Name.ValueString())
+	})
+	if plannedIdx < 0 {
+		return
+	}
+
+	// Prefer the live catalog, but fall back to the resources recorded in state if the current size was withdrawn
+	current, ok := virtualmachines.CurrentVirtualMachineSize(ctx, state, sizes)
+	if !ok {
+		resp.Diagnostics.AddWarning(virtualmachines.ErrSummaryUnableToCompletePlan, fmt.Sprintf(virtualmachines.ErrDetailSizeNoLongerAvailable, state.Size.ValueString()))
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("size"))
+		return
+	}
+
+	shrinking, changes := virtualmachines.DescribeVirtualMachineResize(current, sizes[plannedIdx])
+	if len(shrinking) > 0 {
+		resp.Diagnostics.AddWarning(
+			virtualmachines.WarnSummaryResizeRequiresReplace,
+			fmt.Sprintf(virtualmachines.WarnDetailResizeRequiresReplace, state.Size.ValueString(), plan.Size.ValueString(), changes, strings.Join(shrinking, " and ")),
+		)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("size"))
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		virtualmachines.WarnSummaryResizeInPlace,
+		fmt.Sprintf(virtualmachines.WarnDetailResizeInPlace, state.Size.ValueString(), plan.Size.ValueString(), changes),
+	)
+}
+
+// Plans network_ids when it is not configured, in which case the attached networks are left as they are: the ones in state are
+// kept, and a new virtual machine records the networks the API attaches once it is created. An empty set also leaves them
+// as they are, but it cannot stand for networks that are already in state, since that would plan to detach all of them.
+// Returns the planned value
+func (r *virtualMachinesResource) planNetworkIds(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, plan virtualmachines.ResourceModel) types.Set {
+	var configuredNetworkIds types.Set
+	diags := req.Config.GetAttribute(ctx, path.Root("network_ids"), &configuredNetworkIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || configuredNetworkIds.IsUnknown() || req.State.Raw.IsNull() {
+		return plan.NetworkIds
+	}
+
+	var stateNetworkIds types.Set
+	diags = req.State.GetAttribute(ctx, path.Root("network_ids"), &stateNetworkIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return plan.NetworkIds
+	}
+
+	if configuredNetworkIds.IsNull() {
+		diags = resp.Plan.SetAttribute(ctx, path.Root("network_ids"), stateNetworkIds)
+		resp.Diagnostics.Append(diags...)
+		return stateNetworkIds
+	}
+
+	err := virtualmachines.ValidateAllNetworksAreNotRemoved(stateNetworkIds, configuredNetworkIds)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("network_ids"),
+			virtualmachines.ErrSummaryInvalidAttr,
+			err.Error(),
+		)
+	}
+	return plan.NetworkIds
+}
+
+// Defaults primary_network_id when it is not configured, based on the network_ids planned by planNetworkIds. A single network
+// is always the primary, and the current primary is kept while it stays attached. With several networks there is no order to
+// fall back on, so the primary must be chosen
+func (r *virtualMachinesResource) planPrimaryNetwork(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, plan virtualmachines.ResourceModel) {
+	var configuredPrimaryNetworkId types.String
+	diags := req.Config.GetAttribute(ctx, path.Root("primary_network_id"), &configuredPrimaryNetworkId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !configuredPrimaryNetworkId.IsNull() || plan.NetworkIds.IsUnknown() {
+		return
+	}
+
+	statePrimaryNetworkId := types.StringNull()
+	stateNetworkIds := types.SetNull(types.StringType)
+	if !req.State.Raw.IsNull() {
+		diags = req.State.GetAttribute(ctx, path.Root("primary_network_id"), &statePrimaryNetworkId)
+		resp.Diagnostics.Append(diags...)
+		diags = req.State.GetAttribute(ctx, path.Root("network_ids"), &stateNetworkIds)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	networkIds := plan.NetworkIds.Elements()
+	primaryNetworkId := types.StringUnknown()
+	switch {
+	case len(networkIds) == 1:
+		primaryNetworkId, _ = networkIds[0].(types.String)
+	case len(networkIds) == 0:
+		// An empty network_ids leaves the attached networks as they are, so the primary is the one the API reports. It is
+		// only known once the default network is attached, and is not kept when the networks in state are about to change
+		if len(stateNetworkIds.Elements()) == 0 && !statePrimaryNetworkId.IsNull() {
+			primaryNetworkId = statePrimaryNetworkId
+		}
+	case statePrimaryNetworkId.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("primary_network_id"),
+			virtualmachines.ErrSummaryInvalidAttr,
+			virtualmachines.ErrDetailPrimaryNetworkRequired,
+		)
+		return
+	case slices.ContainsFunc(networkIds, statePrimaryNetworkId.Equal):
+		primaryNetworkId = statePrimaryNetworkId
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("primary_network_id"),
+			virtualmachines.ErrSummaryInvalidAttr,
+			fmt.Sprintf(virtualmachines.ErrDetailPrimaryNetworkRemoved, statePrimaryNetworkId.ValueString()),
+		)
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("primary_network_id"), primaryNetworkId)
+	resp.Diagnostics.Append(diags...)
+}
+
+// UpgradeState migrates state written by earlier schema versions.
+func (r *virtualMachinesResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored network_ids as a list, and the first network in it was the primary
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                 schema.StringAttribute{Computed: true},
+					"name":               schema.StringAttribute{Required: true},
+					"datacenter_id":      schema.StringAttribute{Required: true},
+					"wait_for_startup":   schema.BoolAttribute{Optional: true, Computed: true},
+					"size":               schema.StringAttribute{Required: true},
+					"image":              schema.StringAttribute{Required: true},
+					"created_time":       schema.StringAttribute{Computed: true},
+					"last_updated":       schema.StringAttribute{Computed: true},
+					"location":           schema.MapAttribute{ElementType: types.StringType, Computed: true},
+					"configuration":      schema.MapAttribute{ElementType: types.StringType, Computed: true},
+					"allocate_public_ip": schema.BoolAttribute{Required: true},
+					"network_ids":        schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+					"volume_ids":         schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+					"additional_images": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"id":   schema.Int64Attribute{Computed: true},
+								"name": schema.StringAttribute{Computed: true},
+							},
+						},
+					},
+					"additional_sizes": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"id":   schema.Int64Attribute{Computed: true},
+								"name": schema.StringAttribute{Computed: true},
+								"cpu":  schema.Int64Attribute{Computed: true},
+								"ram":  schema.Int64Attribute{Computed: true},
+								"disk": schema.Int64Attribute{Computed: true},
+							},
+						},
+					},
+					"image_id": schema.Int64Attribute{Computed: true},
+					"size_id":  schema.Int64Attribute{Computed: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				tflog.Info(ctx, virtualmachines.LogStartingUpgradeStateGPCNVirtualMachineV0)
+				var prior virtualmachines.ResourceModelV0
+				diags := req.State.Get(ctx, &prior)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				diags = resp.State.Set(ctx, virtualmachines.UpgradeResourceModelV0(ctx, prior))
+				resp.Diagnostics.Append(diags...)
+			},
+		},
+	}
+}
+
+// IdentitySchema defines the identity used by import blocks and list resources.
+func (r *virtualMachinesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema("Unique identifier for the virtual machine in UUID format")
+}
+
+// ImportState accepts the ID, "name:<name>", "<datacenter_name>/<name>", or an identity.
 func (r *virtualMachinesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByIdOrName(ctx, r.client, req, resp, virtualmachines.ErrSummaryUnableToImportVM, func(datacenterId, name string) (string, string, error) {
+		virtualMachine, err := virtualmachines.GetVirtualMachineByName(r.client, ctx, datacenterId, name)
+		if err != nil {
+			return "", "", err
+		}
+		return virtualMachine.VirtualMachine.ID, virtualMachine.VirtualMachine.DatacenterId, nil
+	})
 }
 
-/*
-  - Some actions can be done without stopping the VM. Since it's a heavy time investment to start and stop, determine that and use it for the rest of the update logic
-    Cases where VM needs to be stopped:
-  - NetworkIds change
-  - VolumeIds change
-  - Size changes
-
-*
-*/
-func determineIfVMNeedsStopped(state, plan virtualmachines.ResourceModel) bool {
-	return (!slices.Equal(plan.NetworkIds.Elements(), state.NetworkIds.Elements())) ||
-		(!slices.Equal(plan.VolumeIds.Elements(), state.VolumeIds.Elements())) ||
-		state.Size != plan.Size
+// Private state of a request, e.g. the Private field of resource.UpdateRequest or resource.ModifyPlanRequest
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// Reads the steps a previous create could not finish. Nothing is pending when the key is missing
+func getPendingCreateSteps(ctx context.Context, private privateStateGetter) (virtualmachines.PendingCreateSteps, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, virtualmachines.PENDING_CREATE_STEPS_PRIVATE_KEY)
+	if diags.HasError() {
+		return virtualmachines.PendingCreateSteps{}, diags
+	}
+
+	pending, err := virtualmachines.ParsePendingCreateSteps(data)
+	if err != nil {
+		diags.AddError(
+			virtualmachines.ErrSummaryUnableToReadPendingCreateSteps,
+			err.Error(),
+		)
+	}
+	return pending, diags
 }
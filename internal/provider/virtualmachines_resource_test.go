@@ -1,6 +1,12 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-gpcn/internal/client"
+	"terraform-provider-gpcn/internal/volumes"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/compare"
@@ -8,12 +14,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 var gpcnVirtualMachineTest = "gpcn_virtualmachine.test"
 
 func TestVirtualMachinesResource(t *testing.T) {
+	importIdByName, checkImportedByName := testImportStateByAttrs(gpcnVirtualMachineTest, "name:%s", "name")
+	importIdByDatacenterName, checkImportedByDatacenterName := testImportStateByAttrs(gpcnVirtualMachineTest, "%s/%s", "location.datacenter", "name")
 	imageIdCompareValuesDiffer := statecheck.CompareValue(compare.ValuesDiffer())
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
@@ -28,7 +38,7 @@ resource "gpcn_network" "vm_network" {
   cidr_block = "10.0.0.0/24"
   dhcp_start_address = "10.0.0.10"
   dhcp_end_address   = "10.0.0.254"
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 
 resource "gpcn_network" "vm_network_custom" {
@@ -57,6 +67,7 @@ resource "gpcn_virtualmachine" "test" {
     gpcn_network.vm_network.id,
     gpcn_network.vm_network_custom.id
   ]
+  primary_network_id = gpcn_network.vm_network.id
 
   volume_ids = [
     gpcn_volume.vm_storage.id
@@ -97,6 +108,20 @@ resource "gpcn_virtualmachine" "test" {
 				ResourceName: gpcnVirtualMachineTest,
 				ImportState:  true,
 			},
+			// ImportState testing by name, searching every datacenter
+			{
+				ResourceName:      gpcnVirtualMachineTest,
+				ImportState:       true,
+				ImportStateIdFunc: importIdByName,
+				ImportStateCheck:  checkImportedByName,
+			},
+			// ImportState testing by datacenter name and name
+			{
+				ResourceName:      gpcnVirtualMachineTest,
+				ImportState:       true,
+				ImportStateIdFunc: importIdByDatacenterName,
+				ImportStateCheck:  checkImportedByDatacenterName,
+			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
@@ -107,7 +132,7 @@ resource "gpcn_network" "vm_network" {
   cidr_block = "10.0.0.0/24"
   dhcp_start_address = "10.0.0.10"
   dhcp_end_address   = "10.0.0.254"
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 
 resource "gpcn_virtualmachine" "test" {
@@ -136,7 +161,7 @@ resource "gpcn_virtualmachine" "test" {
 				ConfigStateChecks: []statecheck.StateCheck{
 					// Verify network and volumes have been removed
 					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("volume_ids"), knownvalue.ListSizeExact(0)),
-					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("network_ids"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(1)),
 				},
 			},
 			{
@@ -148,7 +173,7 @@ resource "gpcn_network" "vm_network" {
   cidr_block = "10.0.0.0/24"
   dhcp_start_address = "10.0.0.10"
   dhcp_end_address   = "10.0.0.254"
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 
 resource "gpcn_virtualmachine" "test" {
@@ -183,12 +208,17 @@ resource "gpcn_virtualmachine" "test" {
 	})
 }
 
-func TestVirtualMachinesChangePublicIpAllocation(t *testing.T) {
+func TestVirtualMachinesResourceUpgradeFromV0(t *testing.T) {
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
-			// Set baseline
+			// Create the virtual machine with the last release that stored network_ids as a list, with the primary first
 			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"gpcn": {
+						Source:            "Global-Private-Cloud-Network/gpcn",
+						VersionConstraint: "0.1.2",
+					},
+				},
 				Config: providerConfig + `
 resource "gpcn_network" "vm_network" {
   name          = "vm-network-standard"
@@ -200,8 +230,14 @@ resource "gpcn_network" "vm_network" {
   dns_servers = "8.8.8.8, 8.8.4.4"
 }
 
+resource "gpcn_network" "vm_network_custom" {
+  name          = "vm-network-custom"
+  network_type  = "custom"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+}
+
 resource "gpcn_virtualmachine" "test" {
-  name          = "terraform-demo-vm"
+  name          = "terraform-demo-vm-upgrade"
   datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
 
   size  = "Micro"
@@ -210,20 +246,15 @@ resource "gpcn_virtualmachine" "test" {
   wait_for_startup = false
   allocate_public_ip = false
   network_ids = [
-    gpcn_network.vm_network.id
+    gpcn_network.vm_network.id,
+    gpcn_network.vm_network_custom.id
   ]
 }
 `,
-				ConfigPlanChecks: resource.ConfigPlanChecks{
-					PreApply: []plancheck.PlanCheck{
-						// Expect initial create action
-						plancheck.ExpectResourceAction("gpcn_network.vm_network", plancheck.ResourceActionCreate),
-						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionCreate),
-					},
-				},
 			},
-			// Update allocate_public_ip to true
+			// The first network of the old list stays the primary, so no primary_network_id is needed
 			{
+				ProtoV6ProviderFactories: testProtoV6ProviderFactories,
 				Config: providerConfig + `
 resource "gpcn_network" "vm_network" {
   name          = "vm-network-standard"
@@ -232,35 +263,49 @@ resource "gpcn_network" "vm_network" {
   cidr_block = "10.0.0.0/24"
   dhcp_start_address = "10.0.0.10"
   dhcp_end_address   = "10.0.0.254"
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_network" "vm_network_custom" {
+  name          = "vm-network-custom"
+  network_type  = "custom"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
 }
 
 resource "gpcn_virtualmachine" "test" {
-  name          = "terraform-demo-vm"
+  name          = "terraform-demo-vm-upgrade"
   datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
 
   size  = "Micro"
   image = "Alma Linux 8.x"
 
   wait_for_startup = false
-  allocate_public_ip = true
+  allocate_public_ip = false
   network_ids = [
-    gpcn_network.vm_network.id
+    gpcn_network.vm_network.id,
+    gpcn_network.vm_network_custom.id
   ]
 }
 `,
-
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						// Expect update
-						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionUpdate),
+						plancheck.ExpectEmptyPlan(),
 					},
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("allocate_public_ip"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(2)),
+					statecheck.CompareValuePairs(gpcnVirtualMachineTest, tfjsonpath.New("primary_network_id"), "gpcn_network.vm_network", tfjsonpath.New("id"), compare.ValuesSame()),
 				},
 			},
-			// Release the IP
+		},
+	})
+}
+
+func TestVirtualMachinesChangePublicIpAllocation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Set baseline
 			{
 				Config: providerConfig + `
 resource "gpcn_network" "vm_network" {
@@ -270,7 +315,7 @@ resource "gpcn_network" "vm_network" {
   cidr_block = "10.0.0.0/24"
   dhcp_start_address = "10.0.0.10"
   dhcp_end_address   = "10.0.0.254"
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 
 resource "gpcn_virtualmachine" "test" {
@@ -287,26 +332,15 @@ resource "gpcn_virtualmachine" "test" {
   ]
 }
 `,
-
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						// Expect update
-						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionUpdate),
+						// Expect initial create action
+						plancheck.ExpectResourceAction("gpcn_network.vm_network", plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionCreate),
 					},
 				},
-				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("allocate_public_ip"), knownvalue.Bool(false)),
-				},
 			},
-		},
-	})
-}
-
-func TestVirtualMachinesSizeUpgrade(t *testing.T) {
-	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
-		Steps: []resource.TestStep{
-			// Create VM with Micro size
+			// Update allocate_public_ip to true
 			{
 				Config: providerConfig + `
 resource "gpcn_network" "vm_network" {
@@ -316,33 +350,35 @@ resource "gpcn_network" "vm_network" {
   cidr_block = "10.0.0.0/24"
   dhcp_start_address = "10.0.0.10"
   dhcp_end_address   = "10.0.0.254"
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 
 resource "gpcn_virtualmachine" "test" {
-  name          = "terraform-size-upgrade-vm"
+  name          = "terraform-demo-vm"
   datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
 
   size  = "Micro"
   image = "Alma Linux 8.x"
 
   wait_for_startup = false
-  allocate_public_ip = false
+  allocate_public_ip = true
   network_ids = [
     gpcn_network.vm_network.id
   ]
 }
 `,
+
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionCreate),
+						// Expect update
+						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionUpdate),
 					},
 				},
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr(gpcnVirtualMachineTest, "size", "Micro"),
-				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("allocate_public_ip"), knownvalue.Bool(true)),
+				},
 			},
-			// Upgrade to Small size - should update in place
+			// Release the IP
 			{
 				Config: providerConfig + `
 resource "gpcn_network" "vm_network" {
@@ -352,14 +388,14 @@ resource "gpcn_network" "vm_network" {
   cidr_block = "10.0.0.0/24"
   dhcp_start_address = "10.0.0.10"
   dhcp_end_address   = "10.0.0.254"
-  dns_servers = "8.8.8.8, 8.8.4.4"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 
 resource "gpcn_virtualmachine" "test" {
-  name          = "terraform-size-upgrade-vm"
+  name          = "terraform-demo-vm"
   datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
 
-  size  = "Small"
+  size  = "Micro"
   image = "Alma Linux 8.x"
 
   wait_for_startup = false
@@ -369,25 +405,27 @@ resource "gpcn_virtualmachine" "test" {
   ]
 }
 `,
+
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						// Expect update, not replace, when upgrading size
+						// Expect update
 						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionUpdate),
 					},
 				},
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr(gpcnVirtualMachineTest, "size", "Small"),
-				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("allocate_public_ip"), knownvalue.Bool(false)),
+				},
 			},
 		},
 	})
 }
 
-func TestVirtualMachinesVolumeAttachment(t *testing.T) {
+func TestVirtualMachinesSwitchPrimaryNetwork(t *testing.T) {
+	primaryNetworkIdMatches := statecheck.CompareValue(compare.ValuesSame())
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
-			// Create VM with no volumes
+			// Set baseline with the public IP on the first network
 			{
 				Config: providerConfig + `
 resource "gpcn_network" "vm_network" {
@@ -397,47 +435,41 @@ resource "gpcn_network" "vm_network" {
   cidr_block = "10.0.0.0/24"
   dhcp_start_address = "10.0.0.10"
   dhcp_end_address   = "10.0.0.254"
-  dns_servers = "8.8.8.8, 8.8.4.4"
-}
-
-resource "gpcn_volume" "vm_vol1" {
-  name          = "vm-storage-vol1"
-  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
-  volume_type   = "SSD"
-  size_gb       = 256
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 
-resource "gpcn_volume" "vm_vol2" {
-  name          = "vm-storage-vol2"
+resource "gpcn_network" "vm_network_second" {
+  name          = "vm-network-standard-second"
+  network_type  = "standard"
   datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
-  volume_type   = "SSD"
-  size_gb       = 256
+  cidr_block = "10.1.0.0/24"
+  dhcp_start_address = "10.1.0.10"
+  dhcp_end_address   = "10.1.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 
 resource "gpcn_virtualmachine" "test" {
-  name          = "terraform-volume-test-vm"
+  name          = "terraform-demo-vm"
   datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
 
   size  = "Micro"
   image = "Alma Linux 8.x"
 
   wait_for_startup = false
-  allocate_public_ip = false
+  allocate_public_ip = true
   network_ids = [
-    gpcn_network.vm_network.id
+    gpcn_network.vm_network.id,
+    gpcn_network.vm_network_second.id
   ]
+  primary_network_id = gpcn_network.vm_network.id
 }
 `,
-				ConfigPlanChecks: resource.ConfigPlanChecks{
-					PreApply: []plancheck.PlanCheck{
-						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionCreate),
-					},
-				},
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("volume_ids"), knownvalue.ListSizeExact(0)),
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(2)),
+					statecheck.CompareValuePairs(gpcnVirtualMachineTest, tfjsonpath.New("primary_network_id"), "gpcn_network.vm_network", tfjsonpath.New("id"), compare.ValuesSame()),
 				},
 			},
-			// Attach one volume
+			// Switch the primary to the second network, which moves the public IP with it
 			{
 				Config: providerConfig + `
 resource "gpcn_network" "vm_network" {
@@ -447,39 +479,33 @@ resource "gpcn_network" "vm_network" {
   cidr_block = "10.0.0.0/24"
   dhcp_start_address = "10.0.0.10"
   dhcp_end_address   = "10.0.0.254"
-  dns_servers = "8.8.8.8, 8.8.4.4"
-}
-
-resource "gpcn_volume" "vm_vol1" {
-  name          = "vm-storage-vol1"
-  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
-  volume_type   = "SSD"
-  size_gb       = 256
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 
-resource "gpcn_volume" "vm_vol2" {
-  name          = "vm-storage-vol2"
+resource "gpcn_network" "vm_network_second" {
+  name          = "vm-network-standard-second"
+  network_type  = "standard"
   datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
-  volume_type   = "SSD"
-  size_gb       = 256
+  cidr_block = "10.1.0.0/24"
+  dhcp_start_address = "10.1.0.10"
+  dhcp_end_address   = "10.1.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 
 resource "gpcn_virtualmachine" "test" {
-  name          = "terraform-volume-test-vm"
+  name          = "terraform-demo-vm"
   datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
 
   size  = "Micro"
   image = "Alma Linux 8.x"
 
   wait_for_startup = false
-  allocate_public_ip = false
+  allocate_public_ip = true
   network_ids = [
-    gpcn_network.vm_network.id
-  ]
-
-  volume_ids = [
-    gpcn_volume.vm_vol1.id
+    gpcn_network.vm_network.id,
+    gpcn_network.vm_network_second.id
   ]
+  primary_network_id = gpcn_network.vm_network_second.id
 }
 `,
 				ConfigPlanChecks: resource.ConfigPlanChecks{
@@ -488,10 +514,12 @@ resource "gpcn_virtualmachine" "test" {
 					},
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("volume_ids"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("allocate_public_ip"), knownvalue.Bool(true)),
+					statecheck.CompareValuePairs(gpcnVirtualMachineTest, tfjsonpath.New("primary_network_id"), "gpcn_network.vm_network_second", tfjsonpath.New("id"), compare.ValuesSame()),
+					primaryNetworkIdMatches.AddStateValue(gpcnVirtualMachineTest, tfjsonpath.New("primary_network_id")),
 				},
 			},
-			// Attach second volume
+			// Removing the old primary network keeps the new primary without configuring it
 			{
 				Config: providerConfig + `
 resource "gpcn_network" "vm_network" {
@@ -501,97 +529,1289 @@ resource "gpcn_network" "vm_network" {
   cidr_block = "10.0.0.0/24"
   dhcp_start_address = "10.0.0.10"
   dhcp_end_address   = "10.0.0.254"
-  dns_servers = "8.8.8.8, 8.8.4.4"
-}
-
-resource "gpcn_volume" "vm_vol1" {
-  name          = "vm-storage-vol1"
-  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
-  volume_type   = "SSD"
-  size_gb       = 256
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 
-resource "gpcn_volume" "vm_vol2" {
-  name          = "vm-storage-vol2"
+resource "gpcn_network" "vm_network_second" {
+  name          = "vm-network-standard-second"
+  network_type  = "standard"
   datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
-  volume_type   = "SSD"
-  size_gb       = 256
+  cidr_block = "10.1.0.0/24"
+  dhcp_start_address = "10.1.0.10"
+  dhcp_end_address   = "10.1.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
 }
 
 resource "gpcn_virtualmachine" "test" {
-  name          = "terraform-volume-test-vm"
+  name          = "terraform-demo-vm"
   datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
 
   size  = "Micro"
   image = "Alma Linux 8.x"
 
   wait_for_startup = false
-  allocate_public_ip = false
+  allocate_public_ip = true
   network_ids = [
-    gpcn_network.vm_network.id
-  ]
-
-  volume_ids = [
-    gpcn_volume.vm_vol1.id,
-    gpcn_volume.vm_vol2.id
+    gpcn_network.vm_network_second.id
   ]
 }
 `,
-				ConfigPlanChecks: resource.ConfigPlanChecks{
-					PreApply: []plancheck.PlanCheck{
-						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionUpdate),
-					},
-				},
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("volume_ids"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(1)),
+					primaryNetworkIdMatches.AddStateValue(gpcnVirtualMachineTest, tfjsonpath.New("primary_network_id")),
 				},
 			},
-			// Remove first volume
+		},
+	})
+}
+
+func TestPrimaryNetworkValidator(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate error is shown when the primary network is not attached
 			{
 				Config: providerConfig + `
-resource "gpcn_network" "vm_network" {
-  name          = "vm-network-standard"
-  network_type  = "standard"
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-demo-vm"
   datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
-  cidr_block = "10.0.0.0/24"
-  dhcp_start_address = "10.0.0.10"
-  dhcp_end_address   = "10.0.0.254"
-  dns_servers = "8.8.8.8, 8.8.4.4"
-}
 
-resource "gpcn_volume" "vm_vol1" {
-  name          = "vm-storage-vol1"
-  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
-  volume_type   = "SSD"
-  size_gb       = 256
-}
+  size  = "Micro"
+  image = "Alma Linux 8.x"
 
-resource "gpcn_volume" "vm_vol2" {
-  name          = "vm-storage-vol2"
-  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
-  volume_type   = "SSD"
-  size_gb       = 256
+  allocate_public_ip = false
+  network_ids = [
+    "3b0f5d1e-7a4c-4e8b-9f2d-1c6a8e4b7d90"
+  ]
+  primary_network_id = "8c2e4a6f-1b3d-4f5a-8e7c-9d0b2a4c6e81"
 }
-
+`,
+				ExpectError: regexp.MustCompile("must also be listed in 'network_ids'"),
+			},
+			// Validate error is shown when several networks are attached without a primary
+			{
+				Config: providerConfig + `
 resource "gpcn_virtualmachine" "test" {
-  name          = "terraform-volume-test-vm"
+  name          = "terraform-demo-vm"
   datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
 
   size  = "Micro"
   image = "Alma Linux 8.x"
 
-  wait_for_startup = false
   allocate_public_ip = false
   network_ids = [
-    gpcn_network.vm_network.id
+    "3b0f5d1e-7a4c-4e8b-9f2d-1c6a8e4b7d90",
+    "8c2e4a6f-1b3d-4f5a-8e7c-9d0b2a4c6e81"
   ]
+}
+`,
+				ExpectError: regexp.MustCompile("'primary_network_id' must be set"),
+			},
+		},
+	})
+}
 
-  volume_ids = [
-    gpcn_volume.vm_vol2.id
-  ]
+func TestVirtualMachinesCatalogValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// An image that is not in the datacenter's catalog fails the plan
+			{
+				Config: providerConfig + `
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-demo-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Windows 3.1"
+
+  wait_for_startup = false
 }
 `,
-				ConfigPlanChecks: resource.ConfigPlanChecks{
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Error verifying the virtual image"),
+			},
+			// So does a size that is not available for the image
+			{
+				Config: providerConfig + `
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-demo-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Gigantic"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Error verifying the size"),
+			},
+		},
+	})
+}
+
+func TestVirtualMachinesSizeUpgrade(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create VM with Micro size
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-size-upgrade-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id
+  ]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(gpcnVirtualMachineTest, "size", "Micro"),
+				),
+			},
+			// Upgrade to Small size - should update in place
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-size-upgrade-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Small"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id
+  ]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						// Expect update, not replace, when upgrading size
+						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(gpcnVirtualMachineTest, "size", "Small"),
+				),
+			},
+		},
+	})
+}
+
+func TestVirtualMachinesAllowStopForUpdate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Set baseline with stops disallowed
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_network" "vm_network_second" {
+  name          = "vm-network-standard-second"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.1.0.0/24"
+  dhcp_start_address = "10.1.0.10"
+  dhcp_end_address   = "10.1.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-demo-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  allow_stop_for_update = false
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id
+  ]
+  primary_network_id = gpcn_network.vm_network.id
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("allow_stop_for_update"), knownvalue.Bool(false)),
+				},
+			},
+			// Attaching a network is hot-plugged, so it is applied without a stop
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_network" "vm_network_second" {
+  name          = "vm-network-standard-second"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.1.0.0/24"
+  dhcp_start_address = "10.1.0.10"
+  dhcp_end_address   = "10.1.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-demo-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  allow_stop_for_update = false
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id,
+    gpcn_network.vm_network_second.id
+  ]
+  primary_network_id = gpcn_network.vm_network.id
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(2)),
+				},
+			},
+			// Resizing needs a stop, so the plan fails while stops are disallowed
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_network" "vm_network_second" {
+  name          = "vm-network-standard-second"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.1.0.0/24"
+  dhcp_start_address = "10.1.0.10"
+  dhcp_end_address   = "10.1.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-demo-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Small"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  allow_stop_for_update = false
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id,
+    gpcn_network.vm_network_second.id
+  ]
+  primary_network_id = gpcn_network.vm_network.id
+}
+`,
+				ExpectError: regexp.MustCompile("'allow_stop_for_update' is false"),
+			},
+			// Detaching a network needs a stop as well
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_network" "vm_network_second" {
+  name          = "vm-network-standard-second"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.1.0.0/24"
+  dhcp_start_address = "10.1.0.10"
+  dhcp_end_address   = "10.1.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-demo-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  allow_stop_for_update = false
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id
+  ]
+  primary_network_id = gpcn_network.vm_network.id
+}
+`,
+				ExpectError: regexp.MustCompile("the network '.+' is detached"),
+			},
+			// Allowing stops applies the detach
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_network" "vm_network_second" {
+  name          = "vm-network-standard-second"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.1.0.0/24"
+  dhcp_start_address = "10.1.0.10"
+  dhcp_end_address   = "10.1.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-demo-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  allow_stop_for_update = true
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id
+  ]
+  primary_network_id = gpcn_network.vm_network.id
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(1)),
+				},
+			},
+		},
+	})
+}
+
+func TestVirtualMachinesRollbackOnFailure(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with rollback enabled. The create finishes, so nothing is rolled back and nothing is left pending
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-demo-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  rollback_on_failure = true
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id
+  ]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("rollback_on_failure"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("created_time"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("primary_network_id"), knownvalue.NotNull()),
+				},
+			},
+			// Changing the setting only affects future creates, so it is updated in place
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-demo-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  rollback_on_failure = false
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id
+  ]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("rollback_on_failure"), knownvalue.Bool(false)),
+				},
+			},
+			// A finished create leaves no steps to resume, so the next plan is empty
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-demo-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  rollback_on_failure = false
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id
+  ]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestVirtualMachinesVolumeAttachment(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create VM with no volumes
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_volume" "vm_vol1" {
+  name          = "vm-storage-vol1"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+resource "gpcn_volume" "vm_vol2" {
+  name          = "vm-storage-vol2"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-volume-test-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id
+  ]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("volume_ids"), knownvalue.ListSizeExact(0)),
+				},
+			},
+			// Attach one volume
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_volume" "vm_vol1" {
+  name          = "vm-storage-vol1"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+resource "gpcn_volume" "vm_vol2" {
+  name          = "vm-storage-vol2"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-volume-test-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id
+  ]
+
+  volume_ids = [
+    gpcn_volume.vm_vol1.id
+  ]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("volume_ids"), knownvalue.ListSizeExact(1)),
+				},
+			},
+			// Attach second volume
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_volume" "vm_vol1" {
+  name          = "vm-storage-vol1"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+resource "gpcn_volume" "vm_vol2" {
+  name          = "vm-storage-vol2"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-volume-test-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id
+  ]
+
+  volume_ids = [
+    gpcn_volume.vm_vol1.id,
+    gpcn_volume.vm_vol2.id
+  ]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("volume_ids"), knownvalue.ListSizeExact(2)),
+				},
+			},
+			// Remove first volume
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_volume" "vm_vol1" {
+  name          = "vm-storage-vol1"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+resource "gpcn_volume" "vm_vol2" {
+  name          = "vm-storage-vol2"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-volume-test-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id
+  ]
+
+  volume_ids = [
+    gpcn_volume.vm_vol2.id
+  ]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("volume_ids"), knownvalue.ListSizeExact(1)),
+				},
+			},
+			// Swap the volumes, which detaches and attaches in the same update
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_volume" "vm_vol1" {
+  name          = "vm-storage-vol1"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+resource "gpcn_volume" "vm_vol2" {
+  name          = "vm-storage-vol2"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-volume-test-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id
+  ]
+
+  volume_ids = [
+    gpcn_volume.vm_vol1.id
+  ]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("volume_ids"), knownvalue.ListSizeExact(1)),
+					statecheck.CompareValuePairs(gpcnVirtualMachineTest, tfjsonpath.New("volume_ids").AtSliceIndex(0), "gpcn_volume.vm_vol1", tfjsonpath.New("id"), compare.ValuesSame()),
+				},
+			},
+		},
+	})
+}
+
+// A virtual machine without network_ids keeps the default network the API attaches, and an empty network_ids does the same
+// without recording it. Neither plans to detach anything until networks are configured
+func TestVirtualMachinesWithoutNetworkIds(t *testing.T) {
+	virtualMachines := `
+resource "gpcn_virtualmachine" "unset" {
+  name          = "tfacc-unset-networks"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  size          = "Micro"
+  image         = "Alma Linux 8.x"
+  allocate_public_ip = false
+  allow_stop_for_update = %[1]s
+}
+
+resource "gpcn_virtualmachine" "empty" {
+  name          = "tfacc-empty-networks"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  size          = "Micro"
+  image         = "Alma Linux 8.x"
+  allocate_public_ip = false
+  allow_stop_for_update = %[1]s
+  network_ids   = []
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The default network is recorded for the virtual machine without network_ids, and is its primary
+			{
+				Config: providerConfig + fmt.Sprintf(virtualMachines, "true"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("gpcn_virtualmachine.unset", tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(1)),
+					statecheck.CompareValueCollection("gpcn_virtualmachine.unset", []tfjsonpath.Path{tfjsonpath.New("network_ids")}, "gpcn_virtualmachine.unset", tfjsonpath.New("primary_network_id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue("gpcn_virtualmachine.empty", tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(0)),
+					statecheck.ExpectKnownValue("gpcn_virtualmachine.empty", tfjsonpath.New("primary_network_id"), knownvalue.NotNull()),
+				},
+			},
+			// An update in place keeps the attached networks, so no stop is needed even when it is not allowed
+			{
+				Config: providerConfig + fmt.Sprintf(virtualMachines, "false"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("gpcn_virtualmachine.unset", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("gpcn_virtualmachine.unset", tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(1)),
+						plancheck.ExpectKnownValue("gpcn_virtualmachine.unset", tfjsonpath.New("primary_network_id"), knownvalue.NotNull()),
+						plancheck.ExpectResourceAction("gpcn_virtualmachine.empty", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("gpcn_virtualmachine.empty", tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(0)),
+						plancheck.ExpectKnownValue("gpcn_virtualmachine.empty", tfjsonpath.New("primary_network_id"), knownvalue.NotNull()),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("gpcn_virtualmachine.unset", tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(1)),
+					statecheck.ExpectKnownValue("gpcn_virtualmachine.empty", tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(0)),
+				},
+			},
+			// An empty network_ids cannot replace the recorded networks, since that would detach all of them
+			{
+				Config: providerConfig + `
+resource "gpcn_virtualmachine" "unset" {
+  name          = "tfacc-unset-networks"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  size          = "Micro"
+  image         = "Alma Linux 8.x"
+  allocate_public_ip = false
+  allow_stop_for_update = false
+  network_ids   = []
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Attribute is invalid"),
+			},
+			// Configuring a network replaces the default network of both, and makes it the primary
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "tfacc-networks-vm-network"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_virtualmachine" "unset" {
+  name          = "tfacc-unset-networks"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  size          = "Micro"
+  image         = "Alma Linux 8.x"
+  allocate_public_ip = false
+  network_ids   = [gpcn_network.vm_network.id]
+}
+
+resource "gpcn_virtualmachine" "empty" {
+  name          = "tfacc-empty-networks"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  size          = "Micro"
+  image         = "Alma Linux 8.x"
+  allocate_public_ip = false
+  network_ids   = [gpcn_network.vm_network.id]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("gpcn_virtualmachine.unset", tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(1)),
+					statecheck.CompareValuePairs("gpcn_virtualmachine.unset", tfjsonpath.New("primary_network_id"), "gpcn_network.vm_network", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue("gpcn_virtualmachine.empty", tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(1)),
+					statecheck.CompareValuePairs("gpcn_virtualmachine.empty", tfjsonpath.New("primary_network_id"), "gpcn_network.vm_network", tfjsonpath.New("id"), compare.ValuesSame()),
+				},
+			},
+		},
+	})
+}
+
+// Removing network_ids from the configuration keeps the attached network and its primary, instead of planning to detach it
+func TestVirtualMachinesPrimaryNetworkWithoutNetworkIds(t *testing.T) {
+	network := `
+resource "gpcn_network" "vm_network" {
+  name          = "tfacc-primary-vm-network"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + network + `
+resource "gpcn_virtualmachine" "test" {
+  name          = "tfacc-primary-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  size          = "Micro"
+  image         = "Alma Linux 8.x"
+  allocate_public_ip = true
+  network_ids   = [gpcn_network.vm_network.id]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(gpcnVirtualMachineTest, tfjsonpath.New("primary_network_id"), "gpcn_network.vm_network", tfjsonpath.New("id"), compare.ValuesSame()),
+				},
+			},
+			// Without network_ids, the plan keeps the network and the primary that are attached, so there is nothing to do
+			{
+				Config: providerConfig + network + `
+resource "gpcn_virtualmachine" "test" {
+  name          = "tfacc-primary-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  size          = "Micro"
+  image         = "Alma Linux 8.x"
+  allocate_public_ip = true
+  allow_stop_for_update = false
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(1)),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(1)),
+					statecheck.CompareValuePairs(gpcnVirtualMachineTest, tfjsonpath.New("primary_network_id"), "gpcn_network.vm_network", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("allocate_public_ip"), knownvalue.Bool(true)),
+				},
+			},
+			// An empty network_ids would detach the primary network, which is rejected before anything changes
+			{
+				Config: providerConfig + network + `
+resource "gpcn_virtualmachine" "test" {
+  name          = "tfacc-primary-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  size          = "Micro"
+  image         = "Alma Linux 8.x"
+  allocate_public_ip = true
+  network_ids   = []
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Attribute is invalid"),
+			},
+		},
+	})
+}
+
+// Changes several networks and volumes in one apply. One of the volumes is attached to another virtual machine, so attaching it
+// fails, but every other change is still made and recorded in the state
+func TestVirtualMachinesPartialAttachmentFailure(t *testing.T) {
+	attachments := `
+resource "gpcn_network" "attachments" {
+  count         = 4
+  name          = "tfacc-partial-network-${count.index}"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.${count.index}.0.0/24"
+  dhcp_start_address = "10.${count.index}.0.10"
+  dhcp_end_address   = "10.${count.index}.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_volume" "attachments" {
+  count         = 3
+  name          = "tfacc-partial-volume-${count.index}"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+resource "gpcn_volume" "taken" {
+  name          = "tfacc-partial-volume-taken"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+resource "gpcn_virtualmachine" "other" {
+  name          = "tfacc-partial-other-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  size          = "Micro"
+  image         = "Alma Linux 8.x"
+  allocate_public_ip = false
+  network_ids   = [gpcn_network.attachments[0].id]
+  volume_ids    = [gpcn_volume.taken.id]
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "tfacc-partial-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  size          = "Micro"
+  image         = "Alma Linux 8.x"
+  allocate_public_ip = false
+  primary_network_id = gpcn_network.attachments[0].id
+  network_ids   = %s
+  volume_ids    = %s
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(attachments,
+					"[gpcn_network.attachments[0].id, gpcn_network.attachments[1].id]",
+					"[gpcn_volume.attachments[0].id, gpcn_volume.attachments[1].id]",
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(2)),
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("volume_ids"), knownvalue.ListSizeExact(2)),
+				},
+			},
+			// Detach a network and a volume, and attach two networks and two volumes, one of which is taken
+			{
+				Config: providerConfig + fmt.Sprintf(attachments,
+					"[gpcn_network.attachments[0].id, gpcn_network.attachments[2].id, gpcn_network.attachments[3].id]",
+					"[gpcn_volume.attachments[0].id, gpcn_volume.attachments[2].id, gpcn_volume.taken.id]",
+				),
+				ExpectError: regexp.MustCompile("Error updating volumes"),
+			},
+			// The state records every change that was made, so only the taken volume is left to do. Without it, there is nothing to do
+			{
+				Config: providerConfig + fmt.Sprintf(attachments,
+					"[gpcn_network.attachments[0].id, gpcn_network.attachments[2].id, gpcn_network.attachments[3].id]",
+					"[gpcn_volume.attachments[0].id, gpcn_volume.attachments[2].id]",
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("network_ids"), knownvalue.SetSizeExact(3)),
+					statecheck.CompareValueCollection(gpcnVirtualMachineTest, []tfjsonpath.Path{tfjsonpath.New("network_ids")}, "gpcn_network.attachments[3]", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("volume_ids"), knownvalue.ListSizeExact(2)),
+					statecheck.CompareValueCollection(gpcnVirtualMachineTest, []tfjsonpath.Path{tfjsonpath.New("volume_ids")}, "gpcn_volume.attachments[2]", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue("gpcn_virtualmachine.other", tfjsonpath.New("volume_ids"), knownvalue.ListSizeExact(1)),
+				},
+			},
+		},
+	})
+}
+
+// An update that fails after stopping the virtual machine starts it again, instead of leaving it powered off
+func TestVirtualMachinesStartsAfterFailedUpdate(t *testing.T) {
+	attachments := `
+resource "gpcn_network" "vm_network" {
+  name          = "tfacc-stop-network"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_volume" "detached" {
+  name          = "tfacc-stop-volume"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+resource "gpcn_volume" "taken" {
+  name          = "tfacc-stop-volume-taken"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+resource "gpcn_virtualmachine" "other" {
+  name          = "tfacc-stop-other-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  size          = "Micro"
+  image         = "Alma Linux 8.x"
+  allocate_public_ip = false
+  network_ids   = [gpcn_network.vm_network.id]
+  volume_ids    = [gpcn_volume.taken.id]
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "tfacc-stop-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  size          = "Micro"
+  image         = "Alma Linux 8.x"
+  wait_for_startup      = true
+  allow_stop_for_update = true
+  allocate_public_ip    = false
+  network_ids   = [gpcn_network.vm_network.id]
+  volume_ids    = %s
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(attachments, "[gpcn_volume.detached.id]"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("volume_ids"), knownvalue.ListSizeExact(1)),
+				},
+			},
+			// Detaching the volume stops the virtual machine, and attaching a volume of another virtual machine then fails
+			{
+				Config:      providerConfig + fmt.Sprintf(attachments, "[gpcn_volume.taken.id]"),
+				ExpectError: regexp.MustCompile("Error updating volumes"),
+			},
+			// The virtual machine is running again, and nothing is left for the next apply to do
+			{
+				Config: providerConfig + fmt.Sprintf(attachments, "[]") + `
+data "gpcn_virtualmachines" "running" {
+  name_regex = "^tfacc-stop-vm$"
+  status     = "running"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionNoop),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.gpcn_virtualmachines.running", tfjsonpath.New("virtual_machines"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(gpcnVirtualMachineTest, tfjsonpath.New("volume_ids"), knownvalue.ListSizeExact(0)),
+				},
+			},
+		},
+	})
+}
+
+func TestVirtualMachinesDetectsAttachmentDrift(t *testing.T) {
+	var volumeId string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create VM with one volume
+			{
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_volume" "vm_vol1" {
+  name          = "vm-storage-drift"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-drift-test-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id
+  ]
+
+  volume_ids = [
+    gpcn_volume.vm_vol1.id
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(state *terraform.State) error {
+						volumeId = state.RootModule().Resources["gpcn_volume.vm_vol1"].Primary.ID
+						return nil
+					},
+				),
+			},
+			// Detach the volume outside of Terraform. Read must notice, and the plan must attach it again
+			{
+				PreConfig: func() {
+					httpClient, err := client.NewHttpClient(os.Getenv("GPCN_HOST"), os.Getenv("GPCN_API_KEY"))
+					if err != nil {
+						t.Fatal(err)
+					}
+					err = volumes.RemoveVolumeFromVirtualMachine(httpClient, context.Background(), volumeId)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: providerConfig + `
+resource "gpcn_network" "vm_network" {
+  name          = "vm-network-standard"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_volume" "vm_vol1" {
+  name          = "vm-storage-drift"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+resource "gpcn_virtualmachine" "test" {
+  name          = "terraform-drift-test-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  size  = "Micro"
+  image = "Alma Linux 8.x"
+
+  wait_for_startup = false
+  allocate_public_ip = false
+  network_ids = [
+    gpcn_network.vm_network.id
+  ]
+
+  volume_ids = [
+    gpcn_volume.vm_vol1.id
+  ]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
 						plancheck.ExpectResourceAction(gpcnVirtualMachineTest, plancheck.ResourceActionUpdate),
 					},
@@ -603,3 +1823,61 @@ resource "gpcn_virtualmachine" "test" {
 		},
 	})
 }
+
+// Import blocks with an identity, both as recorded in state and as written by hand without a datacenter_id
+func TestVirtualMachinesResourceIdentityImport(t *testing.T) {
+	resourceConfig := `
+resource "gpcn_network" "identity" {
+  name          = "tfacc-identity-vm-network"
+  network_type  = "standard"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  cidr_block = "10.0.0.0/24"
+  dhcp_start_address = "10.0.0.10"
+  dhcp_end_address   = "10.0.0.254"
+  dns_servers = ["8.8.8.8", "8.8.4.4"]
+}
+
+resource "gpcn_virtualmachine" "identity" {
+  name          = "tfacc-identity-vm"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  size          = "Micro"
+  image         = "Alma Linux 8.x"
+  allocate_public_ip = false
+  network_ids   = [gpcn_network.identity.id]
+}
+`
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("gpcn_virtualmachine.identity", map[string]knownvalue.Check{
+						"id":            knownvalue.NotNull(),
+						"datacenter_id": knownvalue.StringExact("1ea6b709-0671-46fa-aea8-bdc8eb897d3d"),
+					}),
+				},
+				Check: testCaptureResourceAttr("gpcn_virtualmachine.identity", "id", &id),
+			},
+			// Import block with the identity recorded in state
+			{
+				ResourceName:    "gpcn_virtualmachine.identity",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			// Import block with an identity that only holds the ID. The datacenter_id is read from the API, so the imported
+			// identity matches the one recorded in state
+			{
+				ResourceName:           "gpcn_virtualmachine.identity",
+				ImportState:            true,
+				ImportStateKind:        resource.ImportBlockWithResourceIdentity,
+				ImportStateConfigExact: true,
+				ConfigFile:             testImportByIdentityIdOnlyConfig(t, resourceConfig, "gpcn_virtualmachine.identity", &id),
+			},
+		},
+	})
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-gpcn/internal/volumes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &volumeDataSource{}
+	_ datasource.DataSourceWithConfigure = &volumeDataSource{}
+)
+
+func NewVolumeDataSource() datasource.DataSource {
+	return &volumeDataSource{}
+}
+
+type volumeDataSource struct {
+	client *http.Client
+}
+
+func (d *volumeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume"
+}
+
+func (d *volumeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := volumeDataSourceAttributes()
+	// The lookup keys are configurable, everything else is read from the API
+	attributes["id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Unique identifier of the volume to look up. Exactly one of 'id' or 'name' must be set.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Name of the volume to look up. Must be specified together with 'datacenter_id'. Exactly one of 'id' or 'name' must be set.",
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("datacenter_id")),
+		},
+	}
+	attributes["datacenter_id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Unique identifier of the datacenter to search when looking the volume up by 'name'.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Retrieves information about a single existing GPCN volume, looked up by ID or by name within a datacenter, including the virtual machine it is attached to.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *volumeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			volumes.ErrSummaryUnexpectedConfigureType,
+			fmt.Sprintf(volumes.ErrDetailExpectedHTTPClient, req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *volumeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, volumes.LogStartingReadGPCNVolumeDataSource)
+	var config volumes.VolumeDataResponseTF
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state volumes.VolumeDataResponseTF
+	if !config.ID.IsNull() {
+		getVolumeResponse, err := volumes.GetVolume(d.client, ctx, config.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				volumes.ErrSummaryUnableToGetVolume,
+				fmt.Sprintf(volumes.ErrDetailUnableToGetVolumeWithID, config.ID.ValueString())+": "+err.Error(),
+			)
+			return
+		}
+		state = volumes.MapVolumeDataToTF(getVolumeResponse.Data)
+	} else {
+		volume, err := volumes.GetVolumeByName(d.client, ctx, config.DatacenterId.ValueString(), config.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				volumes.ErrSummaryUnableToGetVolume,
+				err.Error(),
+			)
+			return
+		}
+		state = volumes.MapVolumeDataToTF(*volume)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, volumes.LogSuccessfullyFinishedReadGPCNVolumeDataSource)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestVolumeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Look the same volume up by name and by ID
+			{
+				Config: providerConfig + `
+resource "gpcn_volume" "test" {
+  name          = "tfacc-ds-volume"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+data "gpcn_volume" "by_name" {
+  name          = gpcn_volume.test.name
+  datacenter_id = gpcn_volume.test.datacenter_id
+}
+
+data "gpcn_volume" "by_id" {
+  id = gpcn_volume.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.gpcn_volume.by_name", "id", "gpcn_volume.test", "id"),
+					resource.TestCheckResourceAttr("data.gpcn_volume.by_name", "size_gb", "256"),
+					resource.TestCheckResourceAttr("data.gpcn_volume.by_name", "volume_type", "SSD"),
+					resource.TestCheckResourceAttr("data.gpcn_volume.by_id", "name", "tfacc-ds-volume"),
+					resource.TestCheckResourceAttr("data.gpcn_volume.by_id", "attached", "false"),
+				),
+			},
+			// A name that matches no volume fails instead of returning an empty volume
+			{
+				Config: providerConfig + `
+data "gpcn_volume" "missing" {
+  name          = "tfacc-ds-volume-does-not-exist"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+}
+`,
+				ExpectError: regexp.MustCompile("Unable to get GPCN Volume"),
+			},
+		},
+	})
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-gpcn/internal/volumes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &volumeTypesDataSource{}
+	_ datasource.DataSourceWithConfigure = &volumeTypesDataSource{}
+)
+
+func NewVolumeTypesDataSource() datasource.DataSource {
+	return &volumeTypesDataSource{}
+}
+
+type volumeTypesDataSource struct {
+	client *http.Client
+}
+
+type volumeTypesDataSourceModel struct {
+	DatacenterId types.String `tfsdk:"datacenter_id"`
+	VolumeTypes  types.List   `tfsdk:"volume_types"`
+}
+
+func (d *volumeTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_types"
+}
+
+func (d *volumeTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the volume types (storage tiers) available in a GPCN datacenter together with the sizes each of them can be provisioned with. Use this data source to select a volume type and size for gpcn_volume.",
+		Attributes: map[string]schema.Attribute{
+			"datacenter_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Unique identifier of the datacenter to list volume types for.",
+			},
+			"volume_types": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of volume types available in the datacenter.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Unique identifier for the volume type.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the volume type. Use this value for the 'volume_type' attribute of gpcn_volume.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Additional information about the volume type.",
+						},
+						"available_sizes_gb": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.Int64Type,
+							Description: "Sizes in GB that volumes of this type can be provisioned with. Use one of these values for the 'size_gb' attribute of gpcn_volume.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *volumeTypesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			volumes.ErrSummaryUnexpectedConfigureType,
+			fmt.Sprintf(volumes.ErrDetailExpectedHTTPClient, req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *volumeTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, volumes.LogStartingReadGPCNVolumeTypesDataSource)
+	var state volumeTypesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listVolumeTypesResponse, err := volumes.ListVolumeTypes(d.client, ctx, state.DatacenterId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			volumes.ErrSummaryUnableToListVolumeTypes,
+			fmt.Sprintf(volumes.ErrDetailUnableToListVolumeTypesWithDatacenterID, state.DatacenterId.ValueString())+": "+err.Error(),
+		)
+		return
+	}
+
+	volumeTypes := []volumes.VolumeTypeDataResponseTF{}
+	for _, data := range listVolumeTypesResponse {
+		volumeTypes = append(volumeTypes, volumes.MapVolumeTypeDataToTF(ctx, data))
+	}
+
+	state.VolumeTypes, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: volumes.VolumeTypeDataResponseTF{}.AttrTypes()}, volumeTypes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, volumes.LogSuccessfullyFinishedReadGPCNVolumeTypesDataSource)
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestVolumeTypesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The volume types of the datacenter are discovered together with their sizes
+			{
+				Config: providerConfig + `
+data "gpcn_volume_types" "test" {
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.gpcn_volume_types.test", "volume_types.*", map[string]string{"name": "SSD"}),
+					resource.TestCheckTypeSetElemAttr("data.gpcn_volume_types.test", "volume_types.*.available_sizes_gb.*", "256"),
+					testCheckEveryListElementAttr("data.gpcn_volume_types.test", "volume_types", "id", testInRange(1, math.MaxInt64)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-gpcn/internal/volumes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &volumesDataSource{}
+	_ datasource.DataSourceWithConfigure = &volumesDataSource{}
+)
+
+func NewVolumesDataSource() datasource.DataSource {
+	return &volumesDataSource{}
+}
+
+type volumesDataSource struct {
+	client *http.Client
+}
+
+type volumesDataSourceModel struct {
+	DatacenterId    types.String `tfsdk:"datacenter_id"`
+	VolumeType      types.String `tfsdk:"volume_type"`
+	AttachmentState types.String `tfsdk:"attachment_state"`
+	Volumes         types.List   `tfsdk:"volumes"`
+}
+
+func (d *volumesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volumes"
+}
+
+func (d *volumesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves information about existing GPCN volumes. Use this data source to find volumes by datacenter, volume type, or attachment state, for example to find unattached volumes for cleanup.",
+		Attributes: map[string]schema.Attribute{
+			"datacenter_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter volumes by the unique identifier of their datacenter.",
+			},
+			"volume_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter volumes by type of storage (e.g., 'SSD', 'NVMe'). Case-insensitive.",
+			},
+			"attachment_state": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter volumes by whether they are attached to a virtual machine: either 'attached' or 'unattached'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(volumes.ATTACHMENT_STATE_ATTACHED, volumes.ATTACHMENT_STATE_UNATTACHED),
+				},
+			},
+			"volumes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of volumes matching the specified filter criteria.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: volumeDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Attributes describing a single volume. Shared between the gpcn_volume and gpcn_volumes data sources
+func volumeDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Unique identifier for the volume in UUID format.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Human-readable name of the volume.",
+		},
+		"size_gb": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Size of the volume in GB.",
+		},
+		"volume_size_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Internal identifier for the size of the volume.",
+		},
+		"volume_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "Type of storage (e.g., 'SSD', 'NVMe').",
+		},
+		"volume_type_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Internal identifier for the volume type.",
+		},
+		"datacenter_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Unique identifier of the datacenter where the volume is located.",
+		},
+		"datacenter": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the datacenter where the volume is located.",
+		},
+		"region": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the region where the volume is located.",
+		},
+		"country": schema.StringAttribute{
+			Computed:    true,
+			Description: "Two-letter abbreviation of the country where the volume is located (e.g., 'US').",
+		},
+		"virtual_machine_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Unique identifier of the virtual machine the volume is attached to. Empty when unattached.",
+		},
+		"virtual_machine_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the virtual machine the volume is attached to. Empty when unattached.",
+		},
+		"attached": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the volume is attached to a virtual machine.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timestamp when the volume was created in ISO-8601 format.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "Timestamp when the volume was last updated in ISO-8601 format.",
+		},
+	}
+}
+
+func (d *volumesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			volumes.ErrSummaryUnexpectedConfigureType,
+			fmt.Sprintf(volumes.ErrDetailExpectedHTTPClient, req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *volumesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, volumes.LogStartingReadGPCNVolumesDataSource)
+	var state volumesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listVolumesResponse, err := volumes.ListVolumes(d.client, ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			volumes.ErrSummaryUnableToListVolumes,
+			err.Error(),
+		)
+		return
+	}
+
+	volumeList := []volumes.VolumeDataResponseTF{}
+	for _, data := range listVolumesResponse {
+		volume := volumes.MapVolumeDataToTF(data)
+		if !state.DatacenterId.IsNull() && volume.DatacenterId.ValueString() != state.DatacenterId.ValueString() {
+			continue
+		}
+		if !state.VolumeType.IsNull() && !strings.EqualFold(volume.VolumeType.ValueString(), state.VolumeType.ValueString()) {
+			continue
+		}
+		if !state.AttachmentState.IsNull() && volume.Attached.ValueBool() != (state.AttachmentState.ValueString() == volumes.ATTACHMENT_STATE_ATTACHED) {
+			continue
+		}
+		volumeList = append(volumeList, volume)
+	}
+
+	state.Volumes, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: volumes.VolumeDataResponseTF{}.AttrTypes()}, volumeList)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, volumes.LogSuccessfullyFinishedReadGPCNVolumesDataSource)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestVolumesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// An unattached volume, listed with filters that include and exclude it
+			{
+				Config: providerConfig + `
+resource "gpcn_volume" "test" {
+  name          = "tfacc-ds-volumes"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+
+data "gpcn_volumes" "unattached_ssd" {
+  datacenter_id    = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type      = "SSD"
+  attachment_state = "unattached"
+  depends_on       = [gpcn_volume.test]
+}
+
+data "gpcn_volumes" "attached" {
+  datacenter_id    = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  attachment_state = "attached"
+  depends_on       = [gpcn_volume.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.gpcn_volumes.unattached_ssd", "volumes.*", map[string]string{"name": "tfacc-ds-volumes"}),
+					testCheckEveryListElementAttr("data.gpcn_volumes.unattached_ssd", "volumes", "volume_type", testEquals("SSD")),
+					testCheckEveryListElementAttr("data.gpcn_volumes.unattached_ssd", "volumes", "attached", testEquals("false")),
+					testCheckEveryListElementAttr("data.gpcn_volumes.unattached_ssd", "volumes", "datacenter_id", testEquals("1ea6b709-0671-46fa-aea8-bdc8eb897d3d")),
+					// The unattached volume is filtered out of the attached ones
+					testCheckEveryListElementAttr("data.gpcn_volumes.attached", "volumes", "attached", testEquals("true")),
+					testCheckEveryListElementAttr("data.gpcn_volumes.attached", "volumes", "name", testNotEquals("tfacc-ds-volumes")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"terraform-provider-gpcn/internal/volumes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &volumesListResource{}
+	_ list.ListResourceWithConfigure = &volumesListResource{}
+)
+
+// NewVolumesListResource is a helper function to simplify the provider implementation.
+func NewVolumesListResource() list.ListResource {
+	return &volumesListResource{}
+}
+
+// volumesListResource is the list resource implementation, used by terraform query to discover existing volumes.
+type volumesListResource struct {
+	client *http.Client
+}
+
+type volumesListResourceModel struct {
+	DatacenterId    types.String `tfsdk:"datacenter_id"`
+	VolumeType      types.String `tfsdk:"volume_type"`
+	AttachmentState types.String `tfsdk:"attachment_state"`
+}
+
+// Metadata returns the resource type name. It must match the managed resource.
+func (r *volumesListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume"
+}
+
+// ListResourceConfigSchema defines the filters accepted by list blocks.
+func (r *volumesListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists existing GPCN volumes, including ones not managed by Terraform, so they can be imported",
+		Attributes: map[string]schema.Attribute{
+			"datacenter_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list volumes in the datacenter with this unique identifier",
+			},
+			"volume_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list volumes of this type of storage (e.g., 'SSD', 'NVMe'). Case-insensitive",
+			},
+			"attachment_state": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list volumes that are 'attached' to a virtual machine, or 'unattached' ones",
+				Validators: []validator.String{
+					stringvalidator.OneOf(volumes.ATTACHMENT_STATE_ATTACHED, volumes.ATTACHMENT_STATE_UNATTACHED),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *volumesListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			volumes.ErrSummaryUnexpectedConfigureType,
+			fmt.Sprintf(volumes.ErrDetailExpectedHTTPClient, req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// List streams every volume matching the filters, with its identity and, when requested, its attributes.
+func (r *volumesListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Info(ctx, volumes.LogStartingListGPCNVolumes)
+	var config volumesListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	listVolumesResponse, err := volumes.ListVolumes(r.client, ctx)
+	if err != nil {
+		diags.AddError(
+			volumes.ErrSummaryUnableToListVolumes,
+			err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, data := range listVolumesResponse {
+			volume := volumes.MapVolumeDataToTF(data)
+			if !config.DatacenterId.IsNull() && volume.DatacenterId.ValueString() != config.DatacenterId.ValueString() {
+				continue
+			}
+			if !config.VolumeType.IsNull() && !strings.EqualFold(volume.VolumeType.ValueString(), config.VolumeType.ValueString()) {
+				continue
+			}
+			if !config.AttachmentState.IsNull() && volume.Attached.ValueBool() != (config.AttachmentState.ValueString() == volumes.ATTACHMENT_STATE_ATTACHED) {
+				continue
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = volume.Name.ValueString()
+			result.Diagnostics.Append(setResourceIdentity(ctx, result.Identity, volume.ID, volume.DatacenterId)...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, volumes.MapVolumeDataToModel(ctx, data))...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+		tflog.Info(ctx, volumes.LogSuccessfullyFinishedListGPCNVolumes)
+	}
+}
@@ -2,11 +2,11 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"terraform-provider-gpcn/internal/volumes"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,6 +23,8 @@ var (
 	_ resource.Resource                = &volumesResource{}
 	_ resource.ResourceWithConfigure   = &volumesResource{}
 	_ resource.ResourceWithImportState = &volumesResource{}
+	_ resource.ResourceWithIdentity    = &volumesResource{}
+	_ resource.ResourceWithModifyPlan  = &volumesResource{}
 )
 
 // NewVolumesResource is a helper function to simplify the provider implementation.
@@ -70,11 +71,8 @@ func (r *volumesResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"volume_type": schema.StringAttribute{
-				Description: "Type of storage: either 'SSD' or 'NVMe'. Changing this value requires replacing the volume. Note that not all volume types are available for every datacenter",
+				Description: "Type of storage (e.g., 'SSD' or 'NVMe'). The type is checked against the datacenter's catalog at plan time; use the gpcn_volume_types data source to list them. Changing this value requires replacing the volume",
 				Required:    true,
-				Validators: []validator.String{
-					stringvalidator.OneOf("SSD", "NVMe"),
-				},
 				PlanModifiers: []planmodifier.String{
 					// Changing the volume_type requires us to destroy and create a new volume
 					stringplanmodifier.RequiresReplace(),
@@ -88,7 +86,7 @@ func (r *volumesResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"size_gb": schema.Int64Attribute{
-				Description: "Size of the volume in GB. Can be increased without replacement, but shrinking requires replacing the volume",
+				Description: "Size of the volume in GB. Must be one of the sizes offered for the volume type in the datacenter, which is checked at plan time. Can be increased without replacement, but shrinking requires replacing the volume",
 				Required:    true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
@@ -169,6 +167,12 @@ func (r *volumesResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	diags = setResourceIdentity(ctx, resp.Identity, plan.ID, plan.DatacenterId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, volumes.LogSuccessfullyFinishedCreateGPCNVolume)
 }
 
@@ -201,6 +205,12 @@ func (r *volumesResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	diags = setResourceIdentity(ctx, resp.Identity, state.ID, state.DatacenterId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, volumes.LogSuccessfullyFinishedReadGPCNVolume)
 }
 
@@ -232,6 +242,12 @@ func (r *volumesResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	diags = setResourceIdentity(ctx, resp.Identity, plan.ID, plan.DatacenterId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, volumes.LogSuccessfullyFinishedUpdateGPCNVolume)
 }
 
@@ -257,6 +273,75 @@ func (r *volumesResource) Delete(ctx context.Context, req resource.DeleteRequest
 	tflog.Info(ctx, volumes.LogSuccessfullyFinishedDeleteGPCNVolume)
 }
 
+// ModifyPlan checks the volume type and size against the datacenter's catalog, so invalid values fail at plan time instead of during apply.
+func (r *volumesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when the volume is being destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	tflog.Info(ctx, volumes.LogStartingModifyPlanGPCNVolume)
+	var plan volumes.ResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values coming from other resources can only be validated during apply
+	if plan.DatacenterId.IsUnknown() || plan.VolumeType.IsUnknown() || plan.SizeGb.IsUnknown() {
+		return
+	}
+
+	// The catalog was already checked when the current values were applied
+	if !req.State.Raw.IsNull() {
+		var state volumes.ResourceModel
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.DatacenterId.Equal(state.DatacenterId) && plan.VolumeType.Equal(state.VolumeType) && plan.SizeGb.Equal(state.SizeGb) {
+			return
+		}
+	}
+
+	volumeTypeId, _, err := volumes.GetVolumeSizeId(r.client, ctx, plan.DatacenterId.ValueString(), plan.VolumeType.ValueString(), plan.SizeGb.ValueInt64())
+	if err != nil {
+		// The size is only checked once the volume type is found, so any other error is reported on the volume type
+		attribute := path.Root("volume_type")
+		if errors.Is(err, volumes.ErrVolumeSizeNotAvailable) {
+			attribute = path.Root("size_gb")
+		}
+		resp.Diagnostics.AddAttributeError(
+			attribute,
+			volumes.ErrSummaryInvalidVolumeConfiguration,
+			fmt.Sprintf(volumes.ErrDetailInvalidVolumeConfigurationWithDatacenterID, plan.VolumeType.ValueString(), plan.SizeGb.ValueInt64(), plan.DatacenterId.ValueString())+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("volume_type_id"), types.Int64Value(volumeTypeId))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, volumes.LogSuccessfullyFinishedModifyPlanGPCNVolume)
+}
+
+// IdentitySchema defines the identity used by import blocks and list resources.
+func (r *volumesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema("Unique identifier for the volume in UUID format")
+}
+
+// ImportState accepts the ID, "name:<name>", "<datacenter_name>/<name>", or an identity.
 func (r *volumesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByIdOrName(ctx, r.client, req, resp, volumes.ErrSummaryUnableToImportVolume, func(datacenterId, name string) (string, string, error) {
+		volume, err := volumes.GetVolumeByName(r.client, ctx, datacenterId, name)
+		if err != nil {
+			return "", "", err
+		}
+		return volume.ID, volume.Datacenter.ID, nil
+	})
 }
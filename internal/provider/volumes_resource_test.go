@@ -5,12 +5,17 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 var gpcnVolumesTest = "gpcn_volume.test"
 
 func TestVolumesResource(t *testing.T) {
+	importIdByName, checkImportedByName := testImportStateByAttrs(gpcnVolumesTest, "name:%s", "name")
+	importIdByDatacenterName, checkImportedByDatacenterName := testImportStateByAttrs(gpcnVolumesTest, "%s/%s", "location.datacenter", "name")
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -54,6 +59,20 @@ resource "gpcn_volume" "test" {
 				ResourceName: gpcnVolumesTest,
 				ImportState:  true,
 			},
+			// ImportState testing by name, searching every datacenter
+			{
+				ResourceName:      gpcnVolumesTest,
+				ImportState:       true,
+				ImportStateIdFunc: importIdByName,
+				ImportStateCheck:  checkImportedByName,
+			},
+			// ImportState testing by datacenter name and name
+			{
+				ResourceName:      gpcnVolumesTest,
+				ImportState:       true,
+				ImportStateIdFunc: importIdByDatacenterName,
+				ImportStateCheck:  checkImportedByDatacenterName,
+			},
 			// Update and Read testing with little changes
 			// Increasing the size does not result in a replace
 			{
@@ -159,3 +178,90 @@ resource "gpcn_volume" "test" {
 			},
 		}})
 }
+
+func TestVolumesResourceCatalogValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A size larger than any the volume type offers fails the plan, before anything is created
+			{
+				Config: providerConfig + `
+resource "gpcn_volume" "test" {
+  name = "terraform-demo"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  volume_type = "SSD"
+
+  size_gb = 100000
+}
+`,
+				PlanOnly: true,
+				// The error points at the invalid attribute, so Terraform shows its line
+				ExpectError: regexp.MustCompile(`(?s)Invalid GPCN Volume configuration.*size_gb = 100000`),
+			},
+			// So does a volume type the datacenter does not offer
+			{
+				Config: providerConfig + `
+resource "gpcn_volume" "test" {
+  name = "terraform-demo"
+
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+
+  volume_type = "Tape"
+
+  size_gb = 256
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)Invalid GPCN Volume configuration.*volume_type = "Tape"`),
+			},
+		},
+	})
+}
+
+// Import blocks with an identity, both as recorded in state and as written by hand without a datacenter_id
+func TestVolumesResourceIdentityImport(t *testing.T) {
+	resourceConfig := `
+resource "gpcn_volume" "identity" {
+  name          = "tfacc-identity-volume"
+  datacenter_id = "1ea6b709-0671-46fa-aea8-bdc8eb897d3d"
+  volume_type   = "SSD"
+  size_gb       = 256
+}
+`
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + resourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("gpcn_volume.identity", map[string]knownvalue.Check{
+						"id":            knownvalue.NotNull(),
+						"datacenter_id": knownvalue.StringExact("1ea6b709-0671-46fa-aea8-bdc8eb897d3d"),
+					}),
+				},
+				Check: testCaptureResourceAttr("gpcn_volume.identity", "id", &id),
+			},
+			// Import block with the identity recorded in state
+			{
+				ResourceName:    "gpcn_volume.identity",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			// Import block with an identity that only holds the ID. The datacenter_id is read from the API, so the imported
+			// identity matches the one recorded in state
+			{
+				ResourceName:           "gpcn_volume.identity",
+				ImportState:            true,
+				ImportStateKind:        resource.ImportBlockWithResourceIdentity,
+				ImportStateConfigExact: true,
+				ConfigFile:             testImportByIdentityIdOnlyConfig(t, resourceConfig, "gpcn_volume.identity", &id),
+			},
+		},
+	})
+}
@@ -5,6 +5,13 @@ var DATA_CENTERS_BASE_URL_V1 = "/v1/resource/data-centers/"
 var MAX_NETWORKS_ATTACHED_ALLOWED int = 5
 var MAX_VOLUMES_ATTACHED_ALLOWED int = 5
 var DEFAULT_NETWORK_TIMEOUT_SECONDS int = 300
+var LIST_PAGE_SIZE int = 100
+
+// The size catalog reports RAM in MB, while the configuration of a virtual machine and the RAM filters use GB
+var MB_PER_GB int64 = 1024
+
+// Private state key for the steps of a create that still have to run
+var PENDING_CREATE_STEPS_PRIVATE_KEY = "pending_create_steps"
 
 // Virtual Machine lifecycle statuses
 const (
@@ -45,14 +45,14 @@ type readVirtualMachinesDataVMResponse struct {
 	Country         string `json:"country"`
 }
 
-func CreateVirtualMachine(httpClient *http.Client, ctx context.Context, imageId, sizeId int64, model ResourceModel) (*ReadVirtualMachinesResponse, error) {
+func CreateVirtualMachine(httpClient *http.Client, ctx context.Context, imageId, sizeId int64, model ResourceModel) (string, error) {
 	tflog.Info(ctx, LogStartingCreateVirtualMachine)
 
 	// Allocate public Ip cannot be true if we are attaching a network of type custom
 	tflog.Info(ctx, LogValidatingPublicIPConfiguration)
 	err := ValidatePublicIpValue(httpClient, ctx, model)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	tflog.Info(ctx, LogValidatedPublicIPConfigurationSuccessfully)
 
@@ -72,12 +72,12 @@ func CreateVirtualMachine(httpClient *http.Client, ctx context.Context, imageId,
 		model.NetworkIds.ElementsAs(ctx, &networkIds, true)
 
 		tflog.Info(ctx, LogNetworkIdsNotNull)
-		// Add all network interfaces, setting the first value entered as the primary
+		// Add all network interfaces, setting primary_network_id as the primary. It is only left unknown when there is a single network
 		var networkInterfaces []map[string]any
-		for idx, networkId := range networkIds {
+		for _, networkId := range networkIds {
 			networkInterfaces = append(networkInterfaces, map[string]any{
 				"networkId": networkId,
-				"primary":   idx == 0,
+				"primary":   len(networkIds) == 1 || networkId == model.PrimaryNetworkId.ValueString(),
 			})
 		}
 		createVMRequestBody["networkInterfaces"] = networkInterfaces
@@ -87,20 +87,20 @@ func CreateVirtualMachine(httpClient *http.Client, ctx context.Context, imageId,
 
 	jsonCreateVMRequestBody, err := json.Marshal(createVMRequestBody)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	// Create API request
 	request, err := http.NewRequest("POST", BASE_URL_V1, bytes.NewBuffer(jsonCreateVMRequestBody))
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	tflog.Info(ctx, LogConstructedCreateVMRequest)
 
 	// Perform API request
 	response, err := httpClient.Do(request)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	tflog.Info(ctx, LogIssuedCreateVMJob)
 	defer response.Body.Close()
@@ -108,31 +108,24 @@ func CreateVirtualMachine(httpClient *http.Client, ctx context.Context, imageId,
 	// Read the response body and process it as createVirtualMachineResponse
 	body, err := io.ReadAll(response.Body)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	var createVirtualMachineResponse client.JobStatusMultiResponse
 	err = json.Unmarshal(body, &createVirtualMachineResponse)
 
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	jobResp, err := client.PerformLongPolling(httpClient, ctx, "Create GPCN Virtual Machine", createVirtualMachineResponse.Data.Jobs[0].JobID)
 
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	tflog.Info(ctx, LogLongPollingCompletedCreateVM)
-	// Wait for the VM to actually be spun up before doing anything more
-	getVirtualMachineResponse, err := PollForVirtualMachineStatus(httpClient, ctx, jobResp.Data.Jobs[0].ResourceId, []string{Running, Shutoff}, DEFAULT_NETWORK_TIMEOUT_SECONDS)
-	if err != nil {
-		return nil, err
-	}
-
-	tflog.Info(ctx, LogSuccessfullyProcessedVMCreate)
-	return getVirtualMachineResponse, nil
+	return jobResp.Data.Jobs[0].ResourceId, nil
 }
 
 // Gets a Virtual Machine by its ID
@@ -165,6 +158,50 @@ func GetVirtualMachine(httpClient *http.Client, ctx context.Context, virtualMach
 	return &readVirtualMachinesResponse, nil
 }
 
+// Lists every Virtual Machine visible to the API key, walking through each page of the collection endpoint
+func ListVirtualMachines(httpClient *http.Client, ctx context.Context) ([]readVirtualMachinesDataResponse, error) {
+	tflog.Info(ctx, LogStartingListVirtualMachines)
+	virtualMachines, err := client.ListAllPages[readVirtualMachinesDataResponse](httpClient, ctx, "GPCN Virtual Machines", BASE_URL_V1, LIST_PAGE_SIZE)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyListedVirtualMachines, len(virtualMachines)))
+	return virtualMachines, nil
+}
+
+// Finds the single virtual machine with the given name, optionally scoped to a datacenter. Errors if none or several match
+func GetVirtualMachineByName(httpClient *http.Client, ctx context.Context, datacenterId, name string) (*readVirtualMachinesDataResponse, error) {
+	tflog.Info(ctx, fmt.Sprintf(LogStartingGetVirtualMachineByName, name))
+	allVirtualMachines, err := ListVirtualMachines(httpClient, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []readVirtualMachinesDataResponse
+	var matchingIds []string
+	for _, virtualMachine := range allVirtualMachines {
+		if virtualMachine.VirtualMachine.Name != name {
+			continue
+		}
+		if datacenterId != "" && virtualMachine.VirtualMachine.DatacenterId != datacenterId {
+			continue
+		}
+		matches = append(matches, virtualMachine)
+		matchingIds = append(matchingIds, virtualMachine.VirtualMachine.ID)
+	}
+
+	if len(matches) < 1 {
+		return nil, fmt.Errorf(ErrDetailVMNameNotFound, name, datacenterId)
+	}
+	if len(matches) > 1 {
+		return nil, fmt.Errorf(ErrDetailVMNameAmbiguous, len(matches), name, datacenterId, strings.Join(matchingIds, ", "))
+	}
+
+	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyRetrievedVirtualMachineByName, name))
+	return &matches[0], nil
+}
+
 // Updates a Virtual Machine by its ID
 func UpdateVirtualMachine(httpClient *http.Client, ctx context.Context, virtualMachineId, name string) error {
 	tflog.Info(ctx, fmt.Sprintf(LogStartingUpdateVMWithID, virtualMachineId))
@@ -235,7 +272,7 @@ func PollForVirtualMachineStatus(httpClient *http.Client, ctx context.Context, v
 	return getVirtualMachineResponse, nil
 }
 
-// Verify if public IP is set to true, the first network cannot be of type custom
+// Verify if public IP is set to true, the primary network cannot be of type custom
 func ValidatePublicIpValue(httpClient *http.Client, ctx context.Context, model ResourceModel) error {
 	tflog.Info(ctx, LogStartingValidatePublicIPValue)
 	// If false, no error
@@ -244,16 +281,20 @@ func ValidatePublicIpValue(httpClient *http.Client, ctx context.Context, model R
 		return nil
 	}
 
-	// If true, check if we have networks and check the primary (first) network type
+	// If true, check if we have networks and check the primary network type
 	if model.NetworkIds.IsNull() || len(model.NetworkIds.Elements()) < 1 {
 		tflog.Info(ctx, LogNoNetworksSpecified)
 		return nil
 	}
-	var networkIds []string
-	model.NetworkIds.ElementsAs(ctx, &networkIds, true)
+	primaryNetworkId := model.PrimaryNetworkId.ValueString()
+	if model.PrimaryNetworkId.IsNull() || model.PrimaryNetworkId.IsUnknown() {
+		var networkIds []string
+		model.NetworkIds.ElementsAs(ctx, &networkIds, true)
+		primaryNetworkId = networkIds[0]
+	}
 
 	tflog.Info(ctx, LogValidatingPublicIPSettingByNetworkType)
-	getNetworkResponse, err := networks.GetNetwork(httpClient, ctx, networkIds[0])
+	getNetworkResponse, err := networks.GetNetwork(httpClient, ctx, primaryNetworkId)
 	if err != nil {
 		return err
 	}
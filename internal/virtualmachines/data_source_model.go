@@ -0,0 +1,71 @@
+package virtualmachines
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type VirtualMachineDataResponseTF struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Status          types.String `tfsdk:"status"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+	ConfigurationId types.Int64  `tfsdk:"size_id"`
+	Configuration   types.String `tfsdk:"size"`
+	CPU             types.Int64  `tfsdk:"cpu"`
+	RAM             types.Int64  `tfsdk:"ram"`
+	Disk            types.Int64  `tfsdk:"disk"`
+	Image           types.String `tfsdk:"image"`
+	Username        types.String `tfsdk:"username"`
+	DatacenterId    types.String `tfsdk:"datacenter_id"`
+	Datacenter      types.String `tfsdk:"datacenter"`
+	RegionId        types.Int64  `tfsdk:"region_id"`
+	Region          types.String `tfsdk:"region"`
+	Country         types.String `tfsdk:"country"`
+}
+
+func (o VirtualMachineDataResponseTF) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":            types.StringType,
+		"name":          types.StringType,
+		"status":        types.StringType,
+		"created_at":    types.StringType,
+		"updated_at":    types.StringType,
+		"size_id":       types.Int64Type,
+		"size":          types.StringType,
+		"cpu":           types.Int64Type,
+		"ram":           types.Int64Type,
+		"disk":          types.Int64Type,
+		"image":         types.StringType,
+		"username":      types.StringType,
+		"datacenter_id": types.StringType,
+		"datacenter":    types.StringType,
+		"region_id":     types.Int64Type,
+		"region":        types.StringType,
+		"country":       types.StringType,
+	}
+}
+
+// Convert a single entry of the list or GET response into its data source representation
+func MapVirtualMachineDataToTF(data readVirtualMachinesDataResponse) VirtualMachineDataResponseTF {
+	return VirtualMachineDataResponseTF{
+		ID:              types.StringValue(data.VirtualMachine.ID),
+		Name:            types.StringValue(data.VirtualMachine.Name),
+		Status:          types.StringValue(data.Status),
+		CreatedAt:       types.StringValue(data.VirtualMachine.CreatedAt),
+		UpdatedAt:       types.StringValue(data.VirtualMachine.UpdatedAt),
+		ConfigurationId: types.Int64Value(data.VirtualMachine.ConfigurationId),
+		Configuration:   types.StringValue(data.VirtualMachine.Configuration),
+		CPU:             types.Int64Value(data.VirtualMachine.CPU),
+		RAM:             types.Int64Value(data.VirtualMachine.RAM),
+		Disk:            types.Int64Value(data.VirtualMachine.Disk),
+		Image:           types.StringValue(data.VirtualMachine.Image),
+		Username:        types.StringValue(data.VirtualMachine.Username),
+		DatacenterId:    types.StringValue(data.VirtualMachine.DatacenterId),
+		Datacenter:      types.StringValue(data.VirtualMachine.Datacenter),
+		RegionId:        types.Int64Value(data.VirtualMachine.RegionId),
+		Region:          types.StringValue(data.VirtualMachine.Region),
+		Country:         types.StringValue(data.VirtualMachine.Country),
+	}
+}
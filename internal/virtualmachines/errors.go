@@ -13,15 +13,28 @@ const (
 	ErrSummaryErrorRetrievingNetworkIfaces        = "Error retrieving network interfaces"
 	ErrSummaryErrorUpdatingNetworkInterfaces      = "Error updating network interfaces"
 	ErrSummaryErrorUpdatingVolumes                = "Error updating volumes"
+	ErrSummaryErrorRetrievingVolumes              = "Error retrieving attached volumes"
 	ErrSummaryUnableToCreateDeleteRequest         = "Unable to create a request for deleting a new GPCN Virtual Machine"
 	ErrSummaryUnableToDeleteVM                    = "Unable to delete GPCN Virtual Machine"
 	ErrSummaryUnableToUpdateVM                    = "Unable to update GPCN Virtual Machine"
 	ErrSummaryUnableToStopVM                      = "Unable to stop GPCN Virtual Machine"
+	ErrSummaryUnableToStartVM                     = "Unable to start GPCN Virtual Machine"
 	ErrSummaryErrorReadingDeleteBody              = "Error reading body response GPCN Virtual Machine - Delete"
 	ErrSummaryErrorUnmarshalingDelete             = "Error unmarshaling GPCN Virtual Machine - Delete"
 	ErrSummaryEncounteredErrorGettingJobInfo      = "Encountered an error getting job info"
 	ErrSummaryEncounteredValidationError          = "Encountered a validation error"
 	ErrSummaryUnableToUpdatePublicIPConfiguration = "Unable to update public IP configuration"
+	ErrSummaryUnableToListVMs                     = "Unable to list GPCN Virtual Machines"
+	ErrSummaryInvalidFilter                       = "Invalid filter"
+	ErrSummaryUnableToListImages                  = "Unable to list GPCN Virtual Machine images"
+	ErrSummaryNoMatchingImage                     = "No matching GPCN Virtual Machine image"
+	ErrSummaryUnableToListSizes                   = "Unable to list GPCN Virtual Machine sizes"
+	ErrSummaryNoMatchingSize                      = "No matching GPCN Virtual Machine size"
+	ErrSummaryUnableToImportVM                    = "Unable to import GPCN Virtual Machine"
+	ErrSummaryInvalidAttr                         = "Attribute is invalid"
+	ErrSummaryVMCreateNotFinished                 = "GPCN Virtual Machine was created but could not be finished"
+	ErrSummaryVMCreateRolledBack                  = "GPCN Virtual Machine create was rolled back"
+	ErrSummaryUnableToReadPendingCreateSteps      = "Unable to read the unfinished create steps of GPCN Virtual Machine"
 )
 
 // Warning summary constants
@@ -30,26 +43,48 @@ const (
 	WarnSummaryRemovingNetworkInterfaceFailed = "Removing network interface failed"
 	WarnSummaryRemovingVolumeFailed           = "Removing volume failed"
 	WarnSummaryUnableToStartVM                = "Unable to start GPCN Virtual Machine"
+	WarnSummaryResizeInPlace                  = "Virtual machine will be resized in place"
+	WarnSummaryResizeRequiresReplace          = "Virtual machine must be replaced to change its size"
+	WarnSummaryUpdateStopsVM                  = "Virtual machine will be stopped for this update"
+	WarnSummaryCreateStepsPending             = "Virtual machine create is not finished"
+	WarnSummaryResumingCreate                 = "Virtual machine create will be resumed"
 )
 
 // Error detail message templates
 const (
-	ErrDetailExpectedHTTPClient                 = "Expected *http.Client, got: %T. Please report this issue to the provider developers."
-	ErrDetailSizeNoLongerAvailable              = "The size in the state is no longer available for this datacenter and image. This will require a re-create"
-	ErrDetailSizeNotAvailableForDatacenterImage = "The size '%s' is not available for this datacenter and image. The available values are: %s"
-	ErrDetailImageVerificationFailed            = "Error verifying the virtual image: '%s' for datacenter with ID: '%s'"
-	ErrDetailSizeVerificationFailed             = "Error verifying the size: '%s' for datacenter with ID: '%s'"
-	ErrDetailNetworkInterfacesForNewVM          = "Error retrieving network interfaces for newly created virtual machine with ID: '%s'"
-	ErrDetailNetworkInterfacesForVM             = "Error retrieving network interfaces for virtual machine with ID: '%s'"
-	ErrDetailVMInfoFailedCanImport              = "Retrieving information about the Virtual Machine failed. The job was successful, but Terraform could not read more information about its value. You can import the id to repair the state with terraform import"
-	ErrDetailAddedNetworksExceedsMax            = "this change would exceed the maximum number of networks attached allowed %d"
-	ErrDetailUnableToDeleteVMWithID             = "Unable to delete GPCN Virtual Machine with ID '%s'"
-	ErrDetailUnmarshalingDeleteWithID           = "Error unmarshaling GPCN Virtual Machine - Delete with ID '%s'"
-	ErrDetailJobInfoCheckDashboard              = "Encountered an error getting job info. The request may still have succeeded. Check the GPCN dashboard for more information"
-	ErrDetailStoppingVM                         = "Error stopping virtual machine with ID: '%s'"
-	ErrDetailStartingVM                         = "Error starting virtual machine with ID: '%s'"
-	ErrDetailCannotRemoveLastNetwork            = "unable to remove the last Network attached to a virtual machine"
-	ErrDetailNetworkTypeMustBeStandard          = "the prospective primary network (first in the list) is of type custom. The value for allocatePublicIp can only be set to true if the primary network's network_type is standard"
+	ErrDetailExpectedHTTPClient          = "Expected *http.Client, got: %T. Please report this issue to the provider developers."
+	ErrDetailSizeNoLongerAvailable       = "The size '%s' in the state is no longer available for this datacenter and image and its resources could not be determined. This will require a re-create"
+	ErrDetailImageVerificationFailed     = "Error verifying the virtual image: '%s' for datacenter with ID: '%s'"
+	ErrDetailSizeVerificationFailed      = "Error verifying the size: '%s' for datacenter with ID: '%s'"
+	ErrDetailNetworkInterfacesForNewVM   = "Error retrieving network interfaces for newly created virtual machine with ID: '%s'"
+	ErrDetailNetworkInterfacesForVM      = "Error retrieving network interfaces for virtual machine with ID: '%s'"
+	ErrDetailVolumesForVM                = "Error retrieving the volumes attached to virtual machine with ID: '%s'"
+	ErrDetailUpdatingNetworkWithID       = "Updating the attachment of the network with ID: '%s' failed"
+	ErrDetailUpdatingVolumeWithID        = "Updating the attachment of the volume with ID: '%s' failed"
+	ErrDetailVMInfoFailedCanImport       = "Retrieving information about the Virtual Machine failed. The job was successful, but Terraform could not read more information about its value. You can import the id to repair the state with terraform import"
+	ErrDetailAddedNetworksExceedsMax     = "this change would exceed the maximum number of networks attached allowed %d"
+	ErrDetailUnableToDeleteVMWithID      = "Unable to delete GPCN Virtual Machine with ID '%s'"
+	ErrDetailUnmarshalingDeleteWithID    = "Error unmarshaling GPCN Virtual Machine - Delete with ID '%s'"
+	ErrDetailJobInfoCheckDashboard       = "Encountered an error getting job info. The request may still have succeeded. Check the GPCN dashboard for more information"
+	ErrDetailStoppingVM                  = "Error stopping virtual machine with ID: '%s'"
+	ErrDetailStartingVM                  = "Error starting virtual machine with ID: '%s'"
+	ErrDetailStartingVMAfterFailedUpdate = "The update of the virtual machine with ID: '%s' failed after it was stopped, and starting it again failed as well. The next apply will start it"
+	ErrDetailCannotRemoveLastNetwork     = "unable to remove the last Network attached to a virtual machine. Remove network_ids from the configuration to leave the attached networks as they are"
+	ErrDetailNetworkTypeMustBeStandard   = "the prospective primary network (primary_network_id) is of type custom. The value for allocatePublicIp can only be set to true if the primary network's network_type is standard"
+	ErrDetailPrimaryNetworkNotAttached   = "The primary network '%s' must also be listed in 'network_ids'"
+	ErrDetailPrimaryNetworkRequired      = "'primary_network_id' must be set when the virtual machine is attached to more than one network, since 'network_ids' is unordered"
+	ErrDetailPrimaryNetworkRemoved       = "The current primary network '%s' is removed from 'network_ids'. Set 'primary_network_id' to the network that should take over as the primary"
+	ErrDetailInvalidNameRegex            = "The value '%s' for 'name_regex' is not a valid regular expression: %s"
+	ErrDetailNoImageMatchesFilters       = "No image in the datacenter with ID: '%s' matches the given filters. The available images are: %s"
+	ErrDetailVMNameNotFound              = "No virtual machine named '%s' was found in the datacenter with ID: '%s'"
+	ErrDetailVMNameAmbiguous             = "%d virtual machines named '%s' were found in the datacenter with ID: '%s'. Look the virtual machine up by 'id' instead. Matching IDs are: %s"
+	ErrDetailNoSizeMatchesFilters        = "No size for image '%s' in the datacenter with ID: '%s' matches the given filters. The available sizes are: %s"
+	ErrDetailCreateNotFinished           = "The virtual machine with ID: '%s' was created and saved to the state, but could not be finished. It is marked as tainted, so the next apply will destroy and re-create it. Set 'rollback_on_failure' to true to delete it right away instead"
+	ErrDetailCreateRolledBack            = "The virtual machine with ID: '%s' was created but could not be finished, so it was deleted because 'rollback_on_failure' is true"
+	ErrDetailRollbackFailed              = "The virtual machine with ID: '%s' was created but could not be finished, and deleting it because 'rollback_on_failure' is true failed as well. It is saved to the state and marked as tainted, so the next apply will destroy and re-create it"
+	ErrDetailSizeNotInCatalog            = "The size '%s' is not available for this datacenter and image"
+	ErrDetailResizeShrinks               = "Changing the size from '%s' to '%s' (%s) reduces %s, which cannot be done in place. The plan should have replaced the virtual machine; run terraform plan again to replace it"
+	ErrDetailStopNotAllowed              = "The virtual machine must be stopped for this update, but 'allow_stop_for_update' is false. The update needs a stop because %s. Set 'allow_stop_for_update' to true to allow the virtual machine to be stopped and started again, or limit the change to attaching networks and volumes, which does not need a stop"
 )
 
 // Warning detail message templates
@@ -57,6 +92,11 @@ const (
 	WarnDetailAttachingVolumeWithIDFailed          = "Attaching volume with ID: '%s' failed"
 	WarnDetailRemovingNetworkInterfaceWithIDFailed = "Removing the network interface with ID: '%s' failed"
 	WarnDetailRemovingVolumeWithIDFailed           = "Removing the volume with ID: '%s' failed"
+	WarnDetailResizeInPlace                        = "Changing the size from '%s' to '%s' (%s) does not reduce any resource, so the virtual machine will be resized without being replaced"
+	WarnDetailResizeRequiresReplace                = "Changing the size from '%s' to '%s' (%s) reduces %s. Sizes can only be increased in place, so the virtual machine will be destroyed and re-created"
+	WarnDetailUpdateStopsVM                        = "The virtual machine will be stopped and started again during apply, because %s. Set 'allow_stop_for_update' to false to make plans with such changes fail instead"
+	WarnDetailCreateStepsPending                   = "The virtual machine with ID: '%s' was created, but it still has to %s. The next apply will retry, or set 'rollback_on_failure' to true to delete the virtual machine when its create cannot be finished"
+	WarnDetailResumingCreate                       = "A previous apply created the virtual machine with ID: '%s' but did not %s. This apply will finish the create"
 )
 
 // Polling constants
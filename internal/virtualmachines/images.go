@@ -36,56 +36,67 @@ func (o VirtualMachineImagesDataResponseTF) AttrTypes() map[string]attr.Type {
 	}
 }
 
-// Get virtual machine image ID for a given datacenterId and virtual machine image name
-func GetVirtualMachineImageId(client *http.Client, ctx context.Context, datacenterId, virtualMachineImageName string) (int64, []VirtualMachineImagesDataResponseTF, error) {
-	tflog.Info(ctx, fmt.Sprintf(LogStartingGetVMImageIDWithName, virtualMachineImageName))
+// List the virtual machine images available in a given datacenterId
+func ListVirtualMachineImages(client *http.Client, ctx context.Context, datacenterId string) ([]VirtualMachineImagesDataResponseTF, error) {
+	tflog.Info(ctx, fmt.Sprintf(LogStartingListVMImagesWithDatacenterID, datacenterId))
 	request, err := http.NewRequest("GET", DATA_CENTERS_BASE_URL_V1+datacenterId+"/virtual-machine-images", nil)
-
-	var images []VirtualMachineImagesDataResponseTF
 	if err != nil {
-		return -1, images, err
+		return nil, err
 	}
 
 	response, err := client.Do(request)
 	if err != nil {
-		return -1, images, err
+		return nil, err
 	}
 	defer response.Body.Close()
 
 	body, err := io.ReadAll(response.Body)
 	if err != nil {
-		return -1, images, err
+		return nil, err
 	}
 
 	var virtualMachineImagesResponse virtualMachineImagesResponse
 	err = json.Unmarshal(body, &virtualMachineImagesResponse)
 
 	if err != nil {
-		return -1, images, err
+		return nil, err
 	}
 
-	// Verify the image name specified is available
-	imageIdx := slices.IndexFunc(virtualMachineImagesResponse.Data, func(virtualMachineImage virtualMachineImagesDataResponse) bool {
-		return strings.EqualFold(virtualMachineImage.Name, virtualMachineImageName)
-	})
-
-	var names []string
+	var images []VirtualMachineImagesDataResponseTF
 	for _, image := range virtualMachineImagesResponse.Data {
-		// Used for actual data
 		images = append(images, VirtualMachineImagesDataResponseTF{
 			ID:   types.Int64Value(image.ID),
 			Name: types.StringValue(image.Name),
 		})
-		// Used for helpful error function if needed
-		names = append(names, image.Name)
 	}
 
-	imageNamesFormatted := strings.Join(names, ", ")
+	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyListedVMImagesWithDatacenterID, datacenterId))
+	return images, nil
+}
+
+// Get virtual machine image ID for a given datacenterId and virtual machine image name
+func GetVirtualMachineImageId(client *http.Client, ctx context.Context, datacenterId, virtualMachineImageName string) (int64, []VirtualMachineImagesDataResponseTF, error) {
+	tflog.Info(ctx, fmt.Sprintf(LogStartingGetVMImageIDWithName, virtualMachineImageName))
+	images, err := ListVirtualMachineImages(client, ctx, datacenterId)
+	if err != nil {
+		return -1, images, err
+	}
+
+	// Verify the image name specified is available
+	imageIdx := slices.IndexFunc(images, func(virtualMachineImage VirtualMachineImagesDataResponseTF) bool {
+		return strings.EqualFold(virtualMachineImage.Name.ValueString(), virtualMachineImageName)
+	})
 
 	if imageIdx < 0 {
+		// Used for helpful error function if needed
+		var names []string
+		for _, image := range images {
+			names = append(names, image.Name.ValueString())
+		}
+		imageNamesFormatted := strings.Join(names, ", ")
 		return -1, images, errors.New("the image '" + virtualMachineImageName + "' is not available for this datacenter. Valid images are: " + imageNamesFormatted)
 	}
 
 	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyRetrievedVMImageIDWithName, virtualMachineImageName))
-	return virtualMachineImagesResponse.Data[imageIdx].ID, images, nil
+	return images[imageIdx].ID.ValueInt64(), images, nil
 }
@@ -18,6 +18,14 @@ const (
 	LogStartingGetVMWithID           = "Starting GetVirtualMachine for Virtual Machine ID: %s"
 	LogSuccessfullyRetrievedVMWithID = "Successfully retrieved Virtual Machine with ID: %s"
 
+	// ListVirtualMachines messages
+	LogStartingListVirtualMachines       = "Starting ListVirtualMachines"
+	LogSuccessfullyListedVirtualMachines = "Successfully listed %d Virtual Machines"
+
+	// GetVirtualMachineByName messages
+	LogStartingGetVirtualMachineByName           = "Starting GetVirtualMachineByName for virtual machine name: %s"
+	LogSuccessfullyRetrievedVirtualMachineByName = "Successfully retrieved virtual machine with name: %s"
+
 	// UpdateVirtualMachine messages
 	LogStartingUpdateVMWithID               = "Starting UpdateVirtualMachine for Virtual Machine ID: %s"
 	LogSuccessfullyUpdatedVMWithID          = "Successfully updated Virtual Machine with ID: %s"
@@ -36,10 +44,18 @@ const (
 	LogValidatingPublicIPSettingByNetworkType = "Validating public IP setting by checking primary network type"
 	LogPublicIPValidationPassed               = "Public IP validation passed"
 
+	// ListVirtualMachineImages messages
+	LogStartingListVMImagesWithDatacenterID       = "Starting ListVirtualMachineImages for datacenter ID: %s"
+	LogSuccessfullyListedVMImagesWithDatacenterID = "Successfully listed virtual machine images for datacenter ID: %s"
+
 	// GetVirtualMachineImageId messages
 	LogStartingGetVMImageIDWithName           = "Starting GetVirtualMachineImageId for image name: %s"
 	LogSuccessfullyRetrievedVMImageIDWithName = "Successfully retrived virtual machine image ID for image name: %s"
 
+	// ListVirtualMachineSizes messages
+	LogStartingListVMSizesWithDatacenterID       = "Starting ListVirtualMachineSizes for datacenter ID: %s"
+	LogSuccessfullyListedVMSizesWithDatacenterID = "Successfully listed virtual machine sizes for datacenter ID: %s"
+
 	// GetVirtualMachineSizeId messages
 	LogStartingGetVMSizeIDWithName           = "Starting GetVirtualMachineSizeId for size name: %s"
 	LogSuccessfullyRetrievedVMSizeIDWithName = "Successfully retrived virtual machine size ID for size name: %s"
@@ -61,18 +77,39 @@ const (
 	LogSuccessfullyStoppedVMWithID = "Successfully stopped Virtual Machine with ID: %s"
 
 	// Resource-level CRUD operation messages
-	LogStartingCreateGPCNVirtualMachine             = "Starting Create GPCN Virtual machine"
-	LogSuccessfullyFinishedCreateGPCNVirtualMachine = "Successfully finished Create GPCN Virtual Machine"
-	LogStartingReadGPCNVirtualMachine               = "Starting Read GPCN Virtual Machine"
-	LogSuccessfullyFinishedReadGPCNVirtualMachine   = "Successfully finished Read GPCN Virtual Machine"
-	LogStartingUpdateGPCNVirtualMachine             = "Starting Update GPCN Virtual Machine"
-	LogPerformingVirtualMachineResize               = "Performing Virtual Machine resize"
-	LogNameChangedUpdatingVirtualMachine            = "Name has changed, updating Virtual Machine"
-	LogAllVMUpdateOpsCompleteRetrievingLatestInfo   = "All Virtual Machine update operations are completed, performing GET calls to retrieve latest info"
-	LogRetrievedLatestVMInfoMappingToModel          = "Retrieved latest Virtual Machine info, now mapping to model"
-	LogSuccessfullyFinishedUpdateGPCNVirtualMachine = "Successfully finished Update GPCN Virtual Machine"
-	LogStartingDeleteGPCNVirtualMachine             = "Starting Delete GPCN Virtual Machine"
-	LogConstructedDeleteGPCNVirtualMachineRequest   = "Constructed Delete GPCN Virtual Machine request successfully"
-	LogIssuedDeleteGPCNVirtualMachineJob            = "Successfully issued job to delete GPCN Virtual Machine. Beginning long-polling to check the status"
-	LogSuccessfullyFinishedDeleteGPCNVirtualMachine = "Successfully finished Delete GPCN Virtual Machine"
+	LogStartingCreateGPCNVirtualMachine                 = "Starting Create GPCN Virtual machine"
+	LogSuccessfullyFinishedCreateGPCNVirtualMachine     = "Successfully finished Create GPCN Virtual Machine"
+	LogStartingReadGPCNVirtualMachine                   = "Starting Read GPCN Virtual Machine"
+	LogSuccessfullyFinishedReadGPCNVirtualMachine       = "Successfully finished Read GPCN Virtual Machine"
+	LogStartingUpdateGPCNVirtualMachine                 = "Starting Update GPCN Virtual Machine"
+	LogPerformingVirtualMachineResize                   = "Performing Virtual Machine resize"
+	LogNameChangedUpdatingVirtualMachine                = "Name has changed, updating Virtual Machine"
+	LogAllVMUpdateOpsCompleteRetrievingLatestInfo       = "All Virtual Machine update operations are completed, performing GET calls to retrieve latest info"
+	LogRetrievedLatestVMInfoMappingToModel              = "Retrieved latest Virtual Machine info, now mapping to model"
+	LogSuccessfullyFinishedUpdateGPCNVirtualMachine     = "Successfully finished Update GPCN Virtual Machine"
+	LogStartingDeleteGPCNVirtualMachine                 = "Starting Delete GPCN Virtual Machine"
+	LogConstructedDeleteGPCNVirtualMachineRequest       = "Constructed Delete GPCN Virtual Machine request successfully"
+	LogIssuedDeleteGPCNVirtualMachineJob                = "Successfully issued job to delete GPCN Virtual Machine. Beginning long-polling to check the status"
+	LogSuccessfullyFinishedDeleteGPCNVirtualMachine     = "Successfully finished Delete GPCN Virtual Machine"
+	LogStartingModifyPlanGPCNVirtualMachine             = "Starting ModifyPlan GPCN Virtual Machine"
+	LogSuccessfullyFinishedModifyPlanGPCNVirtualMachine = "Successfully finished ModifyPlan GPCN Virtual Machine"
+	LogStartingUpgradeStateGPCNVirtualMachineV0         = "Starting UpgradeState GPCN Virtual Machine from schema version 0"
+	LogSavedPartialStateGPCNVirtualMachine              = "Saved the ID of the created GPCN Virtual Machine %s to the state before finishing the create"
+	LogRollingBackCreateGPCNVirtualMachine              = "Create of GPCN Virtual Machine %s failed, deleting it since rollback_on_failure is true"
+	LogResumingCreateGPCNVirtualMachine                 = "Resuming the unfinished create of GPCN Virtual Machine %s: %s"
+	LogRecordedAttachmentsAfterFailedUpdate             = "Update of GPCN Virtual Machine %s failed, recorded the networks and volumes that are attached to it"
+	LogUnableToRecordAttachmentsAfterFailedUpdate       = "Update of GPCN Virtual Machine %s failed, and the attached networks and volumes could not be read, keeping the prior state: %s"
+	LogStartingVMAfterFailedUpdate                      = "Update of GPCN Virtual Machine %s failed after it was stopped, starting it again"
+
+	// Data source operation messages
+	LogStartingReadGPCNVirtualMachinesDataSource             = "Starting Read GPCN Virtual Machines data source"
+	LogSuccessfullyFinishedReadGPCNVirtualMachinesDataSource = "Successfully finished Read GPCN Virtual Machines data source"
+	LogStartingReadGPCNImagesDataSource                      = "Starting Read GPCN Images data source"
+	LogSuccessfullyFinishedReadGPCNImagesDataSource          = "Successfully finished Read GPCN Images data source"
+	LogStartingReadGPCNSizesDataSource                       = "Starting Read GPCN Virtual Machine Sizes data source"
+	LogSuccessfullyFinishedReadGPCNSizesDataSource           = "Successfully finished Read GPCN Virtual Machine Sizes data source"
+
+	// List resource operation messages
+	LogStartingListGPCNVirtualMachines             = "Starting List GPCN Virtual Machines list resource"
+	LogSuccessfullyFinishedListGPCNVirtualMachines = "Successfully finished List GPCN Virtual Machines list resource"
 )
@@ -2,15 +2,45 @@ package virtualmachines
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	"terraform-provider-gpcn/internal/helpers"
+	"terraform-provider-gpcn/internal/networks"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type ResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	DatacenterId       types.String `tfsdk:"datacenter_id"`
+	WaitForStartup     types.Bool   `tfsdk:"wait_for_startup"`
+	AllowStopForUpdate types.Bool   `tfsdk:"allow_stop_for_update"`
+	RollbackOnFailure  types.Bool   `tfsdk:"rollback_on_failure"`
+	Size               types.String `tfsdk:"size"`
+	Image              types.String `tfsdk:"image"`
+	CreatedTime        types.String `tfsdk:"created_time"`
+	LastUpdated        types.String `tfsdk:"last_updated"`
+	Location           types.Map    `tfsdk:"location"`
+	Configuration      types.Map    `tfsdk:"configuration"`
+	AllocatePublicIp   types.Bool   `tfsdk:"allocate_public_ip"`
+	NetworkIds         types.Set    `tfsdk:"network_ids"`
+	PrimaryNetworkId   types.String `tfsdk:"primary_network_id"`
+	VolumeIds          types.List   `tfsdk:"volume_ids"`
+	AdditionalImages   types.List   `tfsdk:"additional_images"`
+	AdditionalSizes    types.List   `tfsdk:"additional_sizes"`
+	ImageId            types.Int64  `tfsdk:"image_id"`
+	SizeId             types.Int64  `tfsdk:"size_id"`
+}
+
+// The virtual machine state written by schema version 0, where network_ids was an ordered list whose first element was the primary
+type ResourceModelV0 struct {
 	ID               types.String `tfsdk:"id"`
 	Name             types.String `tfsdk:"name"`
 	DatacenterId     types.String `tfsdk:"datacenter_id"`
@@ -30,10 +60,65 @@ type ResourceModel struct {
 	SizeId           types.Int64  `tfsdk:"size_id"`
 }
 
+// Convert a version 0 state to the current model. The first network in the old list was created as the primary
+func UpgradeResourceModelV0(ctx context.Context, prior ResourceModelV0) ResourceModel {
+	model := ResourceModel{
+		ID:               prior.ID,
+		Name:             prior.Name,
+		DatacenterId:     prior.DatacenterId,
+		WaitForStartup:   prior.WaitForStartup,
+		Size:             prior.Size,
+		Image:            prior.Image,
+		CreatedTime:      prior.CreatedTime,
+		LastUpdated:      prior.LastUpdated,
+		Location:         prior.Location,
+		Configuration:    prior.Configuration,
+		AllocatePublicIp: prior.AllocatePublicIp,
+		VolumeIds:        prior.VolumeIds,
+		AdditionalImages: prior.AdditionalImages,
+		AdditionalSizes:  prior.AdditionalSizes,
+		ImageId:          prior.ImageId,
+		SizeId:           prior.SizeId,
+	}
+	// Version 0 always stopped the virtual machine when needed, and never rolled back a create
+	model.AllowStopForUpdate = types.BoolValue(true)
+	model.RollbackOnFailure = types.BoolValue(false)
+
+	var networkIds []string
+	prior.NetworkIds.ElementsAs(ctx, &networkIds, false)
+	model.NetworkIds, _ = types.SetValueFrom(ctx, types.StringType, networkIds)
+	model.PrimaryNetworkId = types.StringNull()
+	if len(networkIds) > 0 {
+		model.PrimaryNetworkId = types.StringValue(networkIds[0])
+	}
+
+	return model
+}
+
 // Update the plan or state with new values from the GET response
 func MapVirtualMachineResponseToModel(ctx context.Context, response *ReadVirtualMachinesResponse, images []VirtualMachineImagesDataResponseTF, sizes []VirtualMachineSizesDataResponseTF, model ResourceModel) ResourceModel {
 	model.ID = types.StringValue(response.Data.VirtualMachine.ID)
 
+	// Arguments are only unset after an import, in which case they are taken from the API
+	if model.Name.IsNull() {
+		model.Name = types.StringValue(response.Data.VirtualMachine.Name)
+	}
+	if model.DatacenterId.IsNull() {
+		model.DatacenterId = types.StringValue(response.Data.VirtualMachine.DatacenterId)
+	}
+	if model.Size.IsNull() {
+		model.Size = types.StringValue(response.Data.VirtualMachine.Configuration)
+	}
+	if model.Image.IsNull() {
+		model.Image = types.StringValue(response.Data.VirtualMachine.Image)
+	}
+	if model.AllowStopForUpdate.IsNull() {
+		model.AllowStopForUpdate = types.BoolValue(true)
+	}
+	if model.RollbackOnFailure.IsNull() {
+		model.RollbackOnFailure = types.BoolValue(false)
+	}
+
 	// Construct time entries
 	createdTime, err := time.Parse(time.RFC3339, response.Data.VirtualMachine.CreatedAt)
 	if err != nil {
@@ -67,15 +152,238 @@ func MapVirtualMachineResponseToModel(ctx context.Context, response *ReadVirtual
 	model.AdditionalImages, _ = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: VirtualMachineImagesDataResponseTF{}.AttrTypes()}, images)
 	model.AdditionalSizes, _ = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: VirtualMachineSizesDataResponseTF{}.AttrTypes()}, sizes)
 
-	// Find the imageId and sizeId from the objects. Not possible to be < 0
+	// Find the imageId and sizeId from the objects. Images and sizes are not passed when the model is built from the
+	// collection endpoint, and an imported image or size may have been retired. The API reports the size ID, but not the image ID
 	sizeIdx := slices.IndexFunc(sizes, func(virtualMachineSize VirtualMachineSizesDataResponseTF) bool {
 		return strings.EqualFold(virtualMachineSize.Name.ValueString(), model.Size.ValueString())
 	})
 	imageIdx := slices.IndexFunc(images, func(virtualMachineImage VirtualMachineImagesDataResponseTF) bool {
 		return strings.EqualFold(virtualMachineImage.Name.ValueString(), model.Image.ValueString())
 	})
-	model.ImageId = images[imageIdx].ID
-	model.SizeId = sizes[sizeIdx].ID
+	if imageIdx >= 0 {
+		model.ImageId = images[imageIdx].ID
+	}
+	if sizeIdx >= 0 {
+		model.SizeId = sizes[sizeIdx].ID
+	} else {
+		model.SizeId = types.Int64Value(response.Data.VirtualMachine.ConfigurationId)
+	}
+
+	return model
+}
+
+// Build the state of a virtual machine whose create job succeeded, before anything else is known about it. Computed values that
+// are still unknown in the plan are left null, since unknown values cannot be saved to the state
+func MapCreatedVirtualMachineToModel(virtualMachineId string, imageId, sizeId int64, plan ResourceModel) ResourceModel {
+	model := plan
+	model.ID = types.StringValue(virtualMachineId)
+	model.ImageId = types.Int64Value(imageId)
+	model.SizeId = types.Int64Value(sizeId)
+
+	if model.CreatedTime.IsUnknown() {
+		model.CreatedTime = types.StringNull()
+	}
+	if model.LastUpdated.IsUnknown() {
+		model.LastUpdated = types.StringNull()
+	}
+	if model.Location.IsUnknown() {
+		model.Location = types.MapNull(types.StringType)
+	}
+	if model.Configuration.IsUnknown() {
+		model.Configuration = types.MapNull(types.StringType)
+	}
+	if model.AdditionalImages.IsUnknown() {
+		model.AdditionalImages = types.ListNull(types.ObjectType{AttrTypes: VirtualMachineImagesDataResponseTF{}.AttrTypes()})
+	}
+	if model.AdditionalSizes.IsUnknown() {
+		model.AdditionalSizes = types.ListNull(types.ObjectType{AttrTypes: VirtualMachineSizesDataResponseTF{}.AttrTypes()})
+	}
+	if model.PrimaryNetworkId.IsUnknown() {
+		model.PrimaryNetworkId = types.StringNull()
+	}
+	if model.NetworkIds.IsUnknown() {
+		model.NetworkIds = types.SetNull(types.StringType)
+	}
+
+	return model
+}
+
+// Steps of a create that failed after the virtual machine itself was created. They are kept in private state, and the next
+// apply updates the virtual machine to run them again
+type PendingCreateSteps struct {
+	AttachVolumeIds []string `json:"attachVolumeIds,omitempty"`
+	Start           bool     `json:"start,omitempty"`
+}
+
+// Read the pending steps from private state. A missing key means nothing is pending
+func ParsePendingCreateSteps(data []byte) (PendingCreateSteps, error) {
+	var steps PendingCreateSteps
+	if len(data) == 0 {
+		return steps, nil
+	}
+	err := json.Unmarshal(data, &steps)
+	return steps, err
+}
+
+// Encode the pending steps for private state. Nothing pending encodes to nil, which removes the key
+func (s PendingCreateSteps) Marshal() []byte {
+	if s.IsEmpty() {
+		return nil
+	}
+	data, _ := json.Marshal(s)
+	return data
+}
+
+func (s PendingCreateSteps) IsEmpty() bool {
+	return len(s.AttachVolumeIds) == 0 && !s.Start
+}
+
+// Describe the pending steps for diagnostics, e.g. "attach the volumes 'a', 'b' and start the virtual machine"
+func (s PendingCreateSteps) Describe() string {
+	var steps []string
+	if len(s.AttachVolumeIds) > 0 {
+		steps = append(steps, fmt.Sprintf("attach the volumes '%s'", strings.Join(s.AttachVolumeIds, "', '")))
+	}
+	if s.Start {
+		steps = append(steps, "start the virtual machine")
+	}
+	return strings.Join(steps, " and ")
+}
+
+// Update the plan or state with the networks, volumes, and public IP that are attached to the virtual machine. The volumes
+// keep the order they already had in the model. An empty set of networks leaves the attached networks as they are, so it is kept
+func MapAttachmentsToModel(ctx context.Context, networkInterfaces []networks.ReadVirtualMachineNetworkDataResponseTF, volumeIds []string, model ResourceModel) ResourceModel {
+	var priorVolumeIds []string
+	model.VolumeIds.ElementsAs(ctx, &priorVolumeIds, false)
+
+	primaryNetworkId, hasPublicIp := PrimaryNetworkOfInterfaces(networkInterfaces)
+	if model.NetworkIds.IsNull() || len(model.NetworkIds.Elements()) > 0 {
+		model.NetworkIds, _ = types.SetValueFrom(ctx, types.StringType, NetworksOfInterfaces(networkInterfaces))
+	}
+	model.PrimaryNetworkId = types.StringNull()
+	if primaryNetworkId != "" {
+		model.PrimaryNetworkId = types.StringValue(primaryNetworkId)
+	}
+	model.VolumeIds, _ = types.ListValueFrom(ctx, types.StringType, helpers.OrderLike(volumeIds, priorVolumeIds))
+	model.AllocatePublicIp = types.BoolValue(hasPublicIp)
 
 	return model
 }
+
+// Find the networks the interfaces are attached to
+func NetworksOfInterfaces(networkInterfaces []networks.ReadVirtualMachineNetworkDataResponseTF) []string {
+	networkIds := []string{}
+	for _, networkInterface := range networkInterfaces {
+		networkIds = append(networkIds, networkInterface.NetworkID.ValueString())
+	}
+	return networkIds
+}
+
+// Find the network of the primary interface, and whether that interface holds a public IP. The network is empty if no interface is marked as primary
+func PrimaryNetworkOfInterfaces(networkInterfaces []networks.ReadVirtualMachineNetworkDataResponseTF) (string, bool) {
+	primaryIdx := slices.IndexFunc(networkInterfaces, func(networkInterface networks.ReadVirtualMachineNetworkDataResponseTF) bool {
+		return networkInterface.IsPrimary.ValueInt64() == 1
+	})
+	if primaryIdx < 0 {
+		return "", false
+	}
+	return networkInterfaces[primaryIdx].NetworkID.ValueString(), networkInterfaces[primaryIdx].PublicIP.ValueString() != ""
+}
+
+// Describe the planned changes that can only be applied while the virtual machine is stopped. Networks and volumes are
+// hot-plugged when they are attached, so only detaching them, switching the primary interface, and resizing are listed.
+// Attachments that are entirely unknown until apply are listed as well, since they may detach something
+func DescribeChangesRequiringStop(ctx context.Context, state, plan ResourceModel) []string {
+	var changes []string
+
+	if plan.Size.IsUnknown() {
+		changes = append(changes, "size is only known during apply")
+	} else if !plan.Size.Equal(state.Size) {
+		changes = append(changes, fmt.Sprintf("the size changes from '%s' to '%s'", state.Size.ValueString(), plan.Size.ValueString()))
+	}
+
+	if plan.NetworkIds.IsUnknown() {
+		changes = append(changes, "network_ids is only known during apply")
+	} else if len(state.NetworkIds.Elements()) == 0 && len(plan.NetworkIds.Elements()) > 0 {
+		// An empty network_ids did not record the attached networks, so any of them may be detached
+		changes = append(changes, "network_ids was empty, so networks attached outside of it may be detached")
+	} else {
+		for _, networkId := range detachedValues(state.NetworkIds.Elements(), plan.NetworkIds.Elements()) {
+			changes = append(changes, fmt.Sprintf("the network '%s' is detached", networkId))
+		}
+	}
+	if !plan.PrimaryNetworkId.Equal(state.PrimaryNetworkId) && !state.PrimaryNetworkId.IsNull() {
+		changes = append(changes, fmt.Sprintf("the primary network changes from '%s'", state.PrimaryNetworkId.ValueString()))
+	}
+
+	if plan.VolumeIds.IsUnknown() {
+		changes = append(changes, "volume_ids is only known during apply")
+	} else {
+		for _, volumeId := range detachedValues(state.VolumeIds.Elements(), plan.VolumeIds.Elements()) {
+			changes = append(changes, fmt.Sprintf("the volume '%s' is detached", volumeId))
+		}
+	}
+
+	return changes
+}
+
+// Find the values of the state that are no longer planned. A planned value that is unknown comes from a resource that is created or
+// replaced, so it cannot be one of the values that are already attached
+func detachedValues(stateValues, planValues []attr.Value) []string {
+	var detached []string
+	for _, value := range stateValues {
+		stateValue, ok := value.(types.String)
+		if !ok || stateValue.IsNull() || stateValue.IsUnknown() {
+			continue
+		}
+		if !slices.ContainsFunc(planValues, stateValue.Equal) {
+			detached = append(detached, stateValue.ValueString())
+		}
+	}
+	return detached
+}
+
+// Build a model for a virtual machine returned by the collection endpoint. Used by the list resource, where there is no plan
+// or state. Attached networks, volumes, and the image and size catalogs are not part of the collection response and stay unset
+func MapVirtualMachineDataToModel(ctx context.Context, data readVirtualMachinesDataResponse) ResourceModel {
+	model := ResourceModel{
+		NetworkIds:       types.SetNull(types.StringType),
+		PrimaryNetworkId: types.StringNull(),
+		VolumeIds:        types.ListNull(types.StringType),
+	}
+	return MapVirtualMachineResponseToModel(ctx, &ReadVirtualMachinesResponse{Data: data}, nil, nil, model)
+}
+
+// Read the resources of the virtual machine back from the configuration map. Used when the current size is no longer in the catalog.
+// RAM is converted to MB and disk to GB, the units of the catalog, so the result can be compared with catalog sizes
+func MapConfigurationToSize(ctx context.Context, configuration types.Map) (VirtualMachineSizesDataResponseTF, bool) {
+	var values map[string]string
+	diags := configuration.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return VirtualMachineSizesDataResponseTF{}, false
+	}
+
+	// Values are stored with their unit, e.g. "4 cores" or "8 GB". A value without a known unit cannot be compared
+	parse := func(key string, units map[string]int64) (int64, bool) {
+		fields := strings.Fields(values[key])
+		if len(fields) != 2 {
+			return 0, false
+		}
+		value, err := strconv.ParseInt(fields[0], 10, 64)
+		scale, ok := units[strings.ToUpper(fields[1])]
+		return value * scale, err == nil && ok
+	}
+	cpu, cpuOk := parse("cpu", map[string]int64{"CORE": 1, "CORES": 1})
+	ram, ramOk := parse("ram", map[string]int64{"MB": 1, "GB": MB_PER_GB})
+	disk, diskOk := parse("base_storage", map[string]int64{"GB": 1, "TB": 1024})
+	if !cpuOk || !ramOk || !diskOk {
+		return VirtualMachineSizesDataResponseTF{}, false
+	}
+
+	return VirtualMachineSizesDataResponseTF{
+		Name: types.StringValue(values["name"]),
+		CPU:  types.Int64Value(cpu),
+		RAM:  types.Int64Value(ram),
+		Disk: types.Int64Value(disk),
+	}, true
+}
@@ -2,6 +2,7 @@ package virtualmachines
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
@@ -47,39 +48,33 @@ func (o VirtualMachineSizesDataResponseTF) AttrTypes() map[string]attr.Type {
 	}
 }
 
-// Get virtual machine size ID for a given datacenterId and virtual machine image name
-func GetVirtualMachineSizeId(client *http.Client, ctx context.Context, imageId int64, datacenterId, virtualMachineSizeName string) (int64, []VirtualMachineSizesDataResponseTF, error) {
-	tflog.Info(ctx, fmt.Sprintf(LogStartingGetVMSizeIDWithName, virtualMachineSizeName))
+// List the virtual machine sizes available in a given datacenterId for a given imageId
+func ListVirtualMachineSizes(client *http.Client, ctx context.Context, imageId int64, datacenterId string) ([]VirtualMachineSizesDataResponseTF, error) {
+	tflog.Info(ctx, fmt.Sprintf(LogStartingListVMSizesWithDatacenterID, datacenterId))
 	request, err := http.NewRequest("GET", DATA_CENTERS_BASE_URL_V1+datacenterId+"/virtual-machine-sizes?imageId="+strconv.FormatInt(imageId, 10), nil)
-	var sizes []VirtualMachineSizesDataResponseTF
 	if err != nil {
-		return -1, sizes, err
+		return nil, err
 	}
 
 	response, err := client.Do(request)
 	if err != nil {
-		return -1, sizes, err
+		return nil, err
 	}
 	defer response.Body.Close()
 
 	body, err := io.ReadAll(response.Body)
 	if err != nil {
-		return -1, sizes, err
+		return nil, err
 	}
 
 	var virtualMachineSizesResponse virtualMachineSizesResponse
 	err = json.Unmarshal(body, &virtualMachineSizesResponse)
 
 	if err != nil {
-		return -1, sizes, err
+		return nil, err
 	}
 
-	// Verify the size specified is available
-	sizeIdx := slices.IndexFunc(virtualMachineSizesResponse.Data, func(virtualMachineSize virtualMachineSizesDataResponse) bool {
-		return strings.EqualFold(virtualMachineSize.Name, virtualMachineSizeName)
-	})
-
-	var names []string
+	var sizes []VirtualMachineSizesDataResponseTF
 	for _, size := range virtualMachineSizesResponse.Data {
 		sizes = append(sizes, VirtualMachineSizesDataResponseTF{
 			ID:   types.Int64Value(size.ID),
@@ -88,16 +83,109 @@ func GetVirtualMachineSizeId(client *http.Client, ctx context.Context, imageId i
 			RAM:  types.Int64Value(size.RAM),
 			Disk: types.Int64Value(size.Disk),
 		})
-		names = append(names, size.Name)
 	}
-	sizesFormatted := strings.Join(names, ", ")
+
+	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyListedVMSizesWithDatacenterID, datacenterId))
+	return sizes, nil
+}
+
+// Get virtual machine size ID for a given datacenterId and virtual machine image name
+func GetVirtualMachineSizeId(client *http.Client, ctx context.Context, imageId int64, datacenterId, virtualMachineSizeName string) (int64, []VirtualMachineSizesDataResponseTF, error) {
+	tflog.Info(ctx, fmt.Sprintf(LogStartingGetVMSizeIDWithName, virtualMachineSizeName))
+	sizes, err := ListVirtualMachineSizes(client, ctx, imageId, datacenterId)
+	if err != nil {
+		return -1, sizes, err
+	}
+
+	// Verify the size specified is available
+	sizeIdx := slices.IndexFunc(sizes, func(virtualMachineSize VirtualMachineSizesDataResponseTF) bool {
+		return strings.EqualFold(virtualMachineSize.Name.ValueString(), virtualMachineSizeName)
+	})
 
 	if sizeIdx < 0 {
+		var names []string
+		for _, size := range sizes {
+			names = append(names, size.Name.ValueString())
+		}
+		sizesFormatted := strings.Join(names, ", ")
 		return -1, sizes, errors.New("the size '" + virtualMachineSizeName + "' is not available for this datacenter. Valid sizes are: " + sizesFormatted)
 	}
 
 	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyRetrievedVMSizeIDWithName, virtualMachineSizeName))
-	return virtualMachineSizesResponse.Data[sizeIdx].ID, sizes, nil
+	return sizes[sizeIdx].ID.ValueInt64(), sizes, nil
+}
+
+// Orders sizes by CPU, then RAM, then disk. Returns a negative number when a is smaller than b, and a positive number when a is larger
+func CompareVirtualMachineSizes(a, b VirtualMachineSizesDataResponseTF) int {
+	if c := cmp.Compare(a.CPU.ValueInt64(), b.CPU.ValueInt64()); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.RAM.ValueInt64(), b.RAM.ValueInt64()); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.Disk.ValueInt64(), b.Disk.ValueInt64())
+}
+
+// Finds the current size of the virtual machine in the catalog. If the size was withdrawn from the catalog, its resources are
+// read back from the configuration in state instead. Returns false when neither is available
+func CurrentVirtualMachineSize(ctx context.Context, state ResourceModel, sizes []VirtualMachineSizesDataResponseTF) (VirtualMachineSizesDataResponseTF, bool) {
+	currentIdx := slices.IndexFunc(sizes, func(size VirtualMachineSizesDataResponseTF) bool {
+		return strings.EqualFold(state.Size.ValueString(), size.Name.ValueString())
+	})
+	if currentIdx >= 0 {
+		return sizes[currentIdx], true
+	}
+	return MapConfigurationToSize(ctx, state.Configuration)
+}
+
+// Checks that the virtual machine can be resized in place to the planned size, using the same rule as the plan: none of CPU,
+// RAM, or disk may decrease. Returns the ID of the planned size if so
+func CheckVirtualMachineResize(ctx context.Context, state ResourceModel, plannedSizeName string, sizes []VirtualMachineSizesDataResponseTF) (int64, error) {
+	plannedIdx := slices.IndexFunc(sizes, func(size VirtualMachineSizesDataResponseTF) bool {
+		return strings.EqualFold(plannedSizeName, size.Name.ValueString())
+	})
+	if plannedIdx < 0 {
+		return -1, fmt.Errorf(ErrDetailSizeNotInCatalog, plannedSizeName)
+	}
+
+	current, ok := CurrentVirtualMachineSize(ctx, state, sizes)
+	if !ok {
+		return -1, fmt.Errorf(ErrDetailSizeNoLongerAvailable, state.Size.ValueString())
+	}
+
+	shrinking, changes := DescribeVirtualMachineResize(current, sizes[plannedIdx])
+	if len(shrinking) > 0 {
+		return -1, fmt.Errorf(ErrDetailResizeShrinks, state.Size.ValueString(), plannedSizeName, changes, strings.Join(shrinking, " and "))
+	}
+
+	return sizes[plannedIdx].ID.ValueInt64(), nil
+}
+
+// Describes how each resource changes when moving from the current size to the planned size, and which resources shrink. Both
+// sizes are in the units of the catalog, which MapConfigurationToSize also converts to.
+// Sizes can only be increased in place, so any shrinking resource means the virtual machine has to be replaced
+func DescribeVirtualMachineResize(current, planned VirtualMachineSizesDataResponseTF) ([]string, string) {
+	resources := []struct {
+		name    string
+		unit    string
+		current int64
+		planned int64
+	}{
+		{"CPU", "cores", current.CPU.ValueInt64(), planned.CPU.ValueInt64()},
+		{"RAM", "MB", current.RAM.ValueInt64(), planned.RAM.ValueInt64()},
+		{"disk", "GB", current.Disk.ValueInt64(), planned.Disk.ValueInt64()},
+	}
+
+	var shrinking []string
+	var changes []string
+	for _, resource := range resources {
+		changes = append(changes, fmt.Sprintf("%s %d -> %d %s", resource.name, resource.current, resource.planned, resource.unit))
+		if resource.planned < resource.current {
+			shrinking = append(shrinking, resource.name)
+		}
+	}
+
+	return shrinking, strings.Join(changes, ", ")
 }
 
 // Helper function to update a VM by ID
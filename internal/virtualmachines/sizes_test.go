@@ -0,0 +1,120 @@
+package virtualmachines
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testSize(id int64, name string, cpu, ram, disk int64) VirtualMachineSizesDataResponseTF {
+	return VirtualMachineSizesDataResponseTF{
+		ID:   types.Int64Value(id),
+		Name: types.StringValue(name),
+		CPU:  types.Int64Value(cpu),
+		RAM:  types.Int64Value(ram),
+		Disk: types.Int64Value(disk),
+	}
+}
+
+func testState(size string, configuration map[string]string) ResourceModel {
+	state := ResourceModel{
+		Size:          types.StringValue(size),
+		Configuration: types.MapNull(types.StringType),
+	}
+	if configuration != nil {
+		values := map[string]attr.Value{}
+		for key, value := range configuration {
+			values[key] = types.StringValue(value)
+		}
+		state.Configuration = types.MapValueMust(types.StringType, values)
+	}
+	return state
+}
+
+func TestCheckVirtualMachineResize(t *testing.T) {
+	catalog := []VirtualMachineSizesDataResponseTF{
+		testSize(1, "Micro", 1, 2048, 20),
+		testSize(2, "Small", 2, 4096, 40),
+		testSize(3, "Memory", 1, 8192, 20),
+	}
+	withdrawnConfiguration := map[string]string{"name": "Legacy", "cpu": "2 cores", "ram": "2 GB", "base_storage": "40 GB"}
+
+	testCases := map[string]struct {
+		state         ResourceModel
+		plannedSize   string
+		expectedId    int64
+		expectedError string
+	}{
+		"larger size": {
+			state:       testState("Micro", nil),
+			plannedSize: "Small",
+			expectedId:  2,
+		},
+		"more RAM with equal CPU": {
+			state:       testState("Micro", nil),
+			plannedSize: "Memory",
+			expectedId:  3,
+		},
+		"fewer CPUs": {
+			state:         testState("Small", nil),
+			plannedSize:   "Memory",
+			expectedError: "reduces CPU and disk",
+		},
+		"away from a withdrawn size": {
+			state:       testState("Legacy", withdrawnConfiguration),
+			plannedSize: "Small",
+			expectedId:  2,
+		},
+		"shrinking from a withdrawn size": {
+			state:         testState("Legacy", withdrawnConfiguration),
+			plannedSize:   "Micro",
+			expectedError: "reduces CPU and disk",
+		},
+		"withdrawn size with RAM in MB": {
+			state:       testState("Legacy", map[string]string{"name": "Legacy", "cpu": "2 cores", "ram": "3072 MB", "base_storage": "40 GB"}),
+			plannedSize: "Small",
+			expectedId:  2,
+		},
+		"withdrawn size with more RAM in GB than the catalog size in MB": {
+			state:         testState("Legacy", map[string]string{"name": "Legacy", "cpu": "2 cores", "ram": "6 GB", "base_storage": "40 GB"}),
+			plannedSize:   "Small",
+			expectedError: "RAM 6144 -> 4096 MB",
+		},
+		"withdrawn size with an unknown RAM unit": {
+			state:         testState("Legacy", map[string]string{"name": "Legacy", "cpu": "2 cores", "ram": "2 XB", "base_storage": "40 GB"}),
+			plannedSize:   "Small",
+			expectedError: "no longer available",
+		},
+		"withdrawn size without configuration": {
+			state:         testState("Legacy", nil),
+			plannedSize:   "Small",
+			expectedError: "no longer available",
+		},
+		"planned size not in catalog": {
+			state:         testState("Micro", nil),
+			plannedSize:   "Huge",
+			expectedError: "'Huge' is not available",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sizeId, err := CheckVirtualMachineResize(context.Background(), testCase.state, testCase.plannedSize, catalog)
+			if testCase.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if sizeId != testCase.expectedId {
+				t.Fatalf("got size ID %d, expected %d", sizeId, testCase.expectedId)
+			}
+		})
+	}
+}
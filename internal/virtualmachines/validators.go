@@ -5,11 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"slices"
-	"strings"
 	"terraform-provider-gpcn/internal/helpers"
 	"terraform-provider-gpcn/internal/networks"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -23,27 +23,16 @@ func ValidatePlanSizeLargerThanStateSize(httpClient *http.Client, ctx context.Co
 		return -1, err
 	}
 
-	// Verify the new size is larger than the old
-	stateSizeIdx := slices.IndexFunc(sizes, func(virtualMachineSize VirtualMachineSizesDataResponseTF) bool {
-		return strings.EqualFold(virtualMachineSize.Name.ValueString(), state.Size.ValueString())
-	})
-	planSizeIdx := slices.IndexFunc(sizes, func(virtualMachineSize VirtualMachineSizesDataResponseTF) bool {
-		return strings.EqualFold(virtualMachineSize.Name.ValueString(), plan.Size.ValueString())
-	})
-
-	if sizes[stateSizeIdx].CPU.ValueInt64() > sizes[planSizeIdx].CPU.ValueInt64() {
-		return -1, errors.New("the target virtual machine size is smaller than the old size. This shouldn't happen, since the validation should check for a smaller size (and force a replace), but in case it does, make sure the target size is LARGER than the current")
-	}
-
-	return sizes[planSizeIdx].ID.ValueInt64(), nil
+	// Verify no resource shrinks, the same way the plan decided the resize can be done in place
+	return CheckVirtualMachineResize(ctx, state, plan.Size.ValueString(), sizes)
 }
 
-func ValidateAllNetworksAreNotRemoved(oldNetworksList, newNetworksList types.List) error {
-	if oldNetworksList.IsNull() {
+func ValidateAllNetworksAreNotRemoved(oldNetworksList, newNetworksList types.Set) error {
+	if oldNetworksList.IsNull() || len(oldNetworksList.Elements()) == 0 {
 		return nil
 	}
-	// If old networks is not nil and new networks is, that's a problem
-	if newNetworksList.IsNull() {
+	// If old networks is not empty and new networks is, that's a problem
+	if newNetworksList.IsNull() || len(newNetworksList.Elements()) == 0 {
 		return errors.New(ErrDetailCannotRemoveLastNetwork)
 	}
 	return nil
@@ -58,3 +47,42 @@ func ValidateNetworkInterfacesDoesNotExceedCap(oldNetworksList, newNetworksList
 	}
 	return nil
 }
+
+/*
+*
+
+	Config validator for asserting primary_network_id is one of the networks in network_ids
+
+*
+*/
+type PrimaryNetworkConfigValidator struct{}
+
+func (v PrimaryNetworkConfigValidator) Description(ctx context.Context) string {
+	return "Ensures 'primary_network_id' is one of the networks in 'network_ids'."
+}
+func (v PrimaryNetworkConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures `primary_network_id` is one of the networks in `network_ids`."
+}
+func (v PrimaryNetworkConfigValidator) ValidateResource(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config ResourceModel
+	diags := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Networks created in the same configuration are only known during apply
+	if config.PrimaryNetworkId.IsNull() || config.PrimaryNetworkId.IsUnknown() || config.NetworkIds.IsUnknown() {
+		return
+	}
+	for _, networkId := range config.NetworkIds.Elements() {
+		if networkId.IsUnknown() || networkId.Equal(config.PrimaryNetworkId) {
+			return
+		}
+	}
+	response.Diagnostics.AddAttributeError(
+		path.Root("primary_network_id"),
+		ErrSummaryInvalidAttr,
+		fmt.Sprintf(ErrDetailPrimaryNetworkNotAttached, config.PrimaryNetworkId.ValueString()),
+	)
+}
@@ -0,0 +1,60 @@
+package virtualmachines
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testNetworkIds(networkIds ...string) types.Set {
+	values := []attr.Value{}
+	for _, networkId := range networkIds {
+		values = append(values, types.StringValue(networkId))
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+func TestValidateAllNetworksAreNotRemoved(t *testing.T) {
+	testCases := map[string]struct {
+		oldNetworkIds types.Set
+		newNetworkIds types.Set
+		expectError   bool
+	}{
+		"new virtual machine": {
+			oldNetworkIds: types.SetNull(types.StringType),
+			newNetworkIds: testNetworkIds(),
+		},
+		"empty before and after": {
+			oldNetworkIds: testNetworkIds(),
+			newNetworkIds: testNetworkIds(),
+		},
+		"networks configured after an empty set": {
+			oldNetworkIds: testNetworkIds(),
+			newNetworkIds: testNetworkIds("a"),
+		},
+		"one network replaced": {
+			oldNetworkIds: testNetworkIds("a"),
+			newNetworkIds: testNetworkIds("b"),
+		},
+		"every network removed": {
+			oldNetworkIds: testNetworkIds("a", "b"),
+			newNetworkIds: testNetworkIds(),
+			expectError:   true,
+		},
+		"network_ids removed": {
+			oldNetworkIds: testNetworkIds("a"),
+			newNetworkIds: types.SetNull(types.StringType),
+			expectError:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateAllNetworksAreNotRemoved(testCase.oldNetworkIds, testCase.newNetworkIds)
+			if testCase.expectError != (err != nil) {
+				t.Fatalf("expected an error: %t, got: %v", testCase.expectError, err)
+			}
+		})
+	}
+}
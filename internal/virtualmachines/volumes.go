@@ -3,6 +3,7 @@ package virtualmachines
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net/http"
 	"strings"
 	"terraform-provider-gpcn/internal/helpers"
@@ -11,8 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-// UpdateVolumes handles attaching and detaching volumes for a virtual machine
-func UpdateVolumes(httpClient *http.Client, ctx context.Context, vmId string, oldVolumesList, newVolumesList []string) error {
+// UpdateVolumes handles attaching and detaching volumes for a virtual machine. Detaches and then attaches run in parallel. Failures
+// are returned keyed by volume ID, and a failure does not stop the other volumes from being updated
+func UpdateVolumes(httpClient *http.Client, ctx context.Context, vmId string, oldVolumesList, newVolumesList []string) map[string]error {
 	tflog.Info(ctx, "VolumeIds have changed, performing detaches and attaches in that order")
 
 	addedValues, removedValues := helpers.CheckListForDifferences(oldVolumesList, newVolumesList)
@@ -20,30 +22,32 @@ func UpdateVolumes(httpClient *http.Client, ctx context.Context, vmId string, ol
 	tflog.Info(ctx, fmt.Sprintf("VolumeIds to be added are: [%s]", helpers.JoinStrings(addedValues)))
 
 	// Do removals first, since there is a cap of 5 volumes
-	for _, val := range removedValues {
-		tflog.Info(ctx, fmt.Sprintf("Removing volume for ID: %s", val))
+	failures := helpers.RunForEachID(removedValues, helpers.MAX_PARALLEL_ATTACHMENT_JOBS, func(volumeId string) error {
+		tflog.Info(ctx, fmt.Sprintf("Removing volume for ID: %s", volumeId))
 
 		// Make sure volume actually needs to be removed
 		// If the volume was deleted outside of terraform, it would've detached first and the volumeIds wouldn't be updated
-		_, err := volumes.GetVolume(httpClient, ctx, val)
+		_, err := volumes.GetVolume(httpClient, ctx, volumeId)
 		if err != nil && strings.Contains(err.Error(), "404") {
 			// If we are unable to get the volume, this is likely due to it already being deleted. Skip past it
-			continue
+			return nil
 		}
-		err = volumes.RemoveVolumeFromVirtualMachine(httpClient, ctx, val)
+		err = volumes.RemoveVolumeFromVirtualMachine(httpClient, ctx, volumeId)
 		if err != nil {
-			return fmt.Errorf("error removing volume with ID %s: %w", val, err)
+			return fmt.Errorf("error removing volume: %w", err)
 		}
-	}
+		return nil
+	})
 
 	// Add new volumes
-	for _, val := range addedValues {
-		tflog.Info(ctx, fmt.Sprintf("Adding volume for ID: %s", val))
-		err := volumes.AddVolumeToVirtualMachine(httpClient, ctx, vmId, val)
+	maps.Copy(failures, helpers.RunForEachID(addedValues, helpers.MAX_PARALLEL_ATTACHMENT_JOBS, func(volumeId string) error {
+		tflog.Info(ctx, fmt.Sprintf("Adding volume for ID: %s", volumeId))
+		err := volumes.AddVolumeToVirtualMachine(httpClient, ctx, vmId, volumeId)
 		if err != nil {
-			return fmt.Errorf("error adding volume with ID %s: %w", val, err)
+			return fmt.Errorf("error adding volume: %w", err)
 		}
-	}
+		return nil
+	}))
 
-	return nil
+	return failures
 }
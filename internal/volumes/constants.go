@@ -2,7 +2,8 @@ package volumes
 
 var BASE_URL_V1 string = "/v1/resource/volumes/"
 var DATA_CENTERS_BASE_URL_V1 string = "/v1/resource/data-centers/"
-var volumeTypeMapping = map[string]int64{
-	"SSD":  1,
-	"NVMe": 2,
-}
+var LIST_PAGE_SIZE int = 100
+
+// Volume attachment states
+var ATTACHMENT_STATE_ATTACHED = "attached"
+var ATTACHMENT_STATE_UNATTACHED = "unattached"
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"terraform-provider-gpcn/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -44,12 +45,9 @@ type readVolumesDataDatacenterResponse struct {
 
 func CreateVolume(httpClient *http.Client, ctx context.Context, model ResourceModel) (*readVolumesResponse, error) {
 	tflog.Info(ctx, LogStartingCreateVolume)
-	// Find volumeTypeId based on volumeType. This will always be populated because of Schema validation
-	volumeTypeId := volumeTypeMapping[model.VolumeType.ValueString()]
-
 	tflog.Info(ctx, LogLookingUpVolumeSizeID)
-	// Find volumeSizeId and do validation that sizeGb is valid
-	volumeSizeId, err := GetVolumeSizeId(httpClient, ctx, model.DatacenterId.ValueString(), volumeTypeId, model.SizeGb.ValueInt64())
+	// Find volumeTypeId and volumeSizeId from the datacenter's catalog and do validation that sizeGb is valid
+	volumeTypeId, volumeSizeId, err := GetVolumeSizeId(httpClient, ctx, model.DatacenterId.ValueString(), model.VolumeType.ValueString(), model.SizeGb.ValueInt64())
 
 	if err != nil {
 		return nil, err
@@ -142,14 +140,67 @@ func GetVolume(httpClient *http.Client, ctx context.Context, volumeId string) (*
 	return &readVolumesResponse, nil
 }
 
+// Lists every volume visible to the API key, walking through each page of the collection endpoint
+func ListVolumes(httpClient *http.Client, ctx context.Context) ([]readVolumesDataResponse, error) {
+	tflog.Info(ctx, LogStartingListVolumes)
+	volumes, err := client.ListAllPages[readVolumesDataResponse](httpClient, ctx, "GPCN Volumes", BASE_URL_V1, LIST_PAGE_SIZE)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyListedVolumes, len(volumes)))
+	return volumes, nil
+}
+
+// Lists every volume with the given name, optionally scoped to a datacenter
+func ListVolumesByName(httpClient *http.Client, ctx context.Context, datacenterId, name string) ([]readVolumesDataResponse, error) {
+	tflog.Info(ctx, fmt.Sprintf(LogStartingListVolumesByName, name))
+	allVolumes, err := ListVolumes(httpClient, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []readVolumesDataResponse
+	for _, volume := range allVolumes {
+		if volume.Name != name {
+			continue
+		}
+		if datacenterId != "" && volume.Datacenter.ID != datacenterId {
+			continue
+		}
+		matches = append(matches, volume)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyListedVolumesByName, name))
+	return matches, nil
+}
+
+// Finds the single volume with the given name, optionally scoped to a datacenter. Errors if none or several match
+func GetVolumeByName(httpClient *http.Client, ctx context.Context, datacenterId, name string) (*readVolumesDataResponse, error) {
+	matches, err := ListVolumesByName(httpClient, ctx, datacenterId, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(matches) < 1 {
+		return nil, fmt.Errorf(ErrDetailVolumeNameNotFound, name, datacenterId)
+	}
+	if len(matches) > 1 {
+		var matchingIds []string
+		for _, volume := range matches {
+			matchingIds = append(matchingIds, volume.ID)
+		}
+		return nil, fmt.Errorf(ErrDetailVolumeNameAmbiguous, len(matches), name, datacenterId, strings.Join(matchingIds, ", "))
+	}
+
+	return &matches[0], nil
+}
+
 func UpdateVolume(httpClient *http.Client, ctx context.Context, volumeId string, model ResourceModel) (*readVolumesResponse, error) {
 	tflog.Info(ctx, fmt.Sprintf(LogStartingUpdateVolumeWithID, volumeId))
-	// Find volumeTypeId based on volumeType. This will always be populated because of Schema validation
-	volumeTypeId := volumeTypeMapping[model.VolumeType.ValueString()]
-
 	tflog.Info(ctx, LogValidatingVolumeSizeForUpdate)
 	// Do validation that sizeGb is valid
-	_, err := GetVolumeSizeId(httpClient, ctx, model.DatacenterId.ValueString(), volumeTypeId, model.SizeGb.ValueInt64())
+	_, _, err := GetVolumeSizeId(httpClient, ctx, model.DatacenterId.ValueString(), model.VolumeType.ValueString(), model.SizeGb.ValueInt64())
 
 	if err != nil {
 		return nil, err
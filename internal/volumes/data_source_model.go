@@ -0,0 +1,99 @@
+package volumes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type VolumeDataResponseTF struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	SizeGb             types.Int64  `tfsdk:"size_gb"`
+	VolumeSizeId       types.Int64  `tfsdk:"volume_size_id"`
+	VolumeType         types.String `tfsdk:"volume_type"`
+	VolumeTypeId       types.Int64  `tfsdk:"volume_type_id"`
+	DatacenterId       types.String `tfsdk:"datacenter_id"`
+	Datacenter         types.String `tfsdk:"datacenter"`
+	Region             types.String `tfsdk:"region"`
+	Country            types.String `tfsdk:"country"`
+	VirtualMachineId   types.String `tfsdk:"virtual_machine_id"`
+	VirtualMachineName types.String `tfsdk:"virtual_machine_name"`
+	Attached           types.Bool   `tfsdk:"attached"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+}
+
+func (o VolumeDataResponseTF) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                   types.StringType,
+		"name":                 types.StringType,
+		"size_gb":              types.Int64Type,
+		"volume_size_id":       types.Int64Type,
+		"volume_type":          types.StringType,
+		"volume_type_id":       types.Int64Type,
+		"datacenter_id":        types.StringType,
+		"datacenter":           types.StringType,
+		"region":               types.StringType,
+		"country":              types.StringType,
+		"virtual_machine_id":   types.StringType,
+		"virtual_machine_name": types.StringType,
+		"attached":             types.BoolType,
+		"created_at":           types.StringType,
+		"updated_at":           types.StringType,
+	}
+}
+
+// Convert a single entry of the list or GET response into its data source representation
+func MapVolumeDataToTF(data readVolumesDataResponse) VolumeDataResponseTF {
+	return VolumeDataResponseTF{
+		ID:                 types.StringValue(data.ID),
+		Name:               types.StringValue(data.Name),
+		SizeGb:             types.Int64Value(data.SizeGb),
+		VolumeSizeId:       types.Int64Value(data.VolumeSizeId),
+		VolumeType:         types.StringValue(data.VolumeType.Name),
+		VolumeTypeId:       types.Int64Value(data.VolumeType.ID),
+		DatacenterId:       types.StringValue(data.Datacenter.ID),
+		Datacenter:         types.StringValue(data.Datacenter.Name),
+		Region:             types.StringValue(data.Datacenter.Region),
+		Country:            types.StringValue(data.Datacenter.Country),
+		VirtualMachineId:   types.StringValue(data.VirtualMachineId),
+		VirtualMachineName: types.StringValue(data.VirtualMachineName),
+		Attached:           types.BoolValue(data.VirtualMachineId != ""),
+		CreatedAt:          types.StringValue(data.CreatedAt),
+		UpdatedAt:          types.StringValue(data.UpdatedAt),
+	}
+}
+
+type VolumeTypeDataResponseTF struct {
+	ID               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	AvailableSizesGb types.List   `tfsdk:"available_sizes_gb"`
+}
+
+func (o VolumeTypeDataResponseTF) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                 types.Int64Type,
+		"name":               types.StringType,
+		"description":        types.StringType,
+		"available_sizes_gb": types.ListType{ElemType: types.Int64Type},
+	}
+}
+
+// Convert a single volume type of the volume-sizes response into its data source representation
+func MapVolumeTypeDataToTF(ctx context.Context, data volumeSizesDataVolumeTypesResponse) VolumeTypeDataResponseTF {
+	sizes := []int64{}
+	for _, size := range data.AvailableSizes {
+		sizes = append(sizes, size.SizeGb)
+	}
+	sizesList, _ := types.ListValueFrom(ctx, types.Int64Type, sizes)
+
+	return VolumeTypeDataResponseTF{
+		ID:               types.Int64Value(data.ID),
+		Name:             types.StringValue(data.Name),
+		Description:      types.StringValue(data.Description),
+		AvailableSizesGb: sizesList,
+	}
+}
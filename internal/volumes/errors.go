@@ -2,17 +2,25 @@ package volumes
 
 // Error summary constants
 const (
-	ErrSummaryUnexpectedConfigureType = "Unexpected Data Source Configure Type"
-	ErrSummaryUnableToCreateVolume    = "Unable to create GPCN Volume"
-	ErrSummaryUnableToGetVolume       = "Unable to get GPCN Volume"
-	ErrSummaryUnableToUpdateVolume    = "Unable to update GPCN Volume"
-	ErrSummaryUnableToDeleteVolume    = "Unable to delete GPCN Volume"
+	ErrSummaryUnexpectedConfigureType    = "Unexpected Data Source Configure Type"
+	ErrSummaryUnableToCreateVolume       = "Unable to create GPCN Volume"
+	ErrSummaryUnableToGetVolume          = "Unable to get GPCN Volume"
+	ErrSummaryUnableToUpdateVolume       = "Unable to update GPCN Volume"
+	ErrSummaryUnableToDeleteVolume       = "Unable to delete GPCN Volume"
+	ErrSummaryUnableToListVolumes        = "Unable to list GPCN Volumes"
+	ErrSummaryUnableToListVolumeTypes    = "Unable to list GPCN Volume Types"
+	ErrSummaryInvalidVolumeConfiguration = "Invalid GPCN Volume configuration"
+	ErrSummaryUnableToImportVolume       = "Unable to import GPCN Volume"
 )
 
 // Error detail message templates
 const (
-	ErrDetailExpectedHTTPClient         = "Expected *http.Client, got: %T. Please report this issue to the provider developers."
-	ErrDetailUnableToGetVolumeWithID    = "Unable to get GPCN Volume with ID: '%s'"
-	ErrDetailUnableToUpdateVolumeWithID = "Unable to update GPCN Volume with ID: '%s'"
-	ErrDetailUnableToDeleteVolumeWithID = "Unable to delete GPCN Volume with ID: '%s'"
+	ErrDetailExpectedHTTPClient                         = "Expected *http.Client, got: %T. Please report this issue to the provider developers."
+	ErrDetailUnableToGetVolumeWithID                    = "Unable to get GPCN Volume with ID: '%s'"
+	ErrDetailUnableToUpdateVolumeWithID                 = "Unable to update GPCN Volume with ID: '%s'"
+	ErrDetailUnableToDeleteVolumeWithID                 = "Unable to delete GPCN Volume with ID: '%s'"
+	ErrDetailVolumeNameNotFound                         = "No volume named '%s' was found in the datacenter with ID: '%s'"
+	ErrDetailVolumeNameAmbiguous                        = "%d volumes named '%s' were found in the datacenter with ID: '%s'. Look the volume up by 'id' instead. Matching IDs are: %s"
+	ErrDetailUnableToListVolumeTypesWithDatacenterID    = "Unable to list volume types for the datacenter with ID: '%s'"
+	ErrDetailInvalidVolumeConfigurationWithDatacenterID = "Volume type '%s' with a size of %d GB cannot be used in the datacenter with ID: '%s'"
 )
@@ -14,6 +14,10 @@ const (
 	LogStartingGetVolumeWithID           = "Starting GetVolume for volume ID: %s"
 	LogSuccessfullyRetrievedVolumeWithID = "Successfully retrieved volume with ID: %s"
 
+	// ListVolumes messages
+	LogStartingListVolumes       = "Starting ListVolumes"
+	LogSuccessfullyListedVolumes = "Successfully listed %d volumes"
+
 	// ListVolumesByName messages
 	LogStartingListVolumesByName       = "Starting ListVolumesByName for volume name: %s"
 	LogSuccessfullyListedVolumesByName = "Successfully retrieved volume with name: %s"
@@ -32,19 +36,37 @@ const (
 	LogIssuedDeleteVolumeJob                   = "Successfully issued job to delete GPCN Volume. Beginning long-polling to check the status"
 	LogSuccessfullyCompletedDeleteVolumeWithID = "Successfully completed DeleteVolume for volume ID: %s"
 
+	// ListVolumeTypes messages
+	LogStartingListVolumeTypesWithDatacenterID       = "Starting ListVolumeTypes for datacenter ID: %s"
+	LogSuccessfullyListedVolumeTypesWithDatacenterID = "Successfully listed %d volume types for datacenter ID: %s"
+
 	// GetVolumeSizeId messages
-	LogStartingGetVolumeSizeIDWithParams           = "Starting GetVolumeSizeId for volume type ID: %s and size: %s"
+	LogStartingGetVolumeSizeIDWithParams           = "Starting GetVolumeSizeId for volume type: %s and size: %s"
 	LogValidatingVolumeTypeAvailable               = "Validating volume type is available"
 	LogValidatingVolumeSizeAvailable               = "Validating volume size is available"
-	LogSuccessfullyRetrievedVolumeSizeIDWithParams = "Successfully retrieved volume size ID for volume type ID: %s and size: %s"
+	LogSuccessfullyRetrievedVolumeSizeIDWithParams = "Successfully retrieved volume size ID for volume type: %s and size: %s"
 
 	// Resource-level CRUD operation messages
-	LogStartingCreateGPCNVolume             = "Starting Create GPCN Volume"
-	LogSuccessfullyFinishedCreateGPCNVolume = "Successfully finished Create GPCN Volume"
-	LogStartingReadGPCNVolume               = "Starting Read GPCN Volume"
-	LogSuccessfullyFinishedReadGPCNVolume   = "Successfully finished Read GPCN Volume"
-	LogStartingUpdateGPCNVolume             = "Starting Update GPCN Volume"
-	LogSuccessfullyFinishedUpdateGPCNVolume = "Successfully finished Update GPCN Volume"
-	LogStartingDeleteGPCNVolume             = "Starting Delete GPCN Volume"
-	LogSuccessfullyFinishedDeleteGPCNVolume = "Successfully finished Delete GPCN Volume"
+	LogStartingCreateGPCNVolume                 = "Starting Create GPCN Volume"
+	LogSuccessfullyFinishedCreateGPCNVolume     = "Successfully finished Create GPCN Volume"
+	LogStartingReadGPCNVolume                   = "Starting Read GPCN Volume"
+	LogSuccessfullyFinishedReadGPCNVolume       = "Successfully finished Read GPCN Volume"
+	LogStartingUpdateGPCNVolume                 = "Starting Update GPCN Volume"
+	LogSuccessfullyFinishedUpdateGPCNVolume     = "Successfully finished Update GPCN Volume"
+	LogStartingDeleteGPCNVolume                 = "Starting Delete GPCN Volume"
+	LogSuccessfullyFinishedDeleteGPCNVolume     = "Successfully finished Delete GPCN Volume"
+	LogStartingModifyPlanGPCNVolume             = "Starting ModifyPlan GPCN Volume"
+	LogSuccessfullyFinishedModifyPlanGPCNVolume = "Successfully finished ModifyPlan GPCN Volume"
+
+	// Data source operation messages
+	LogStartingReadGPCNVolumeDataSource                  = "Starting Read GPCN Volume data source"
+	LogSuccessfullyFinishedReadGPCNVolumeDataSource      = "Successfully finished Read GPCN Volume data source"
+	LogStartingReadGPCNVolumesDataSource                 = "Starting Read GPCN Volumes data source"
+	LogSuccessfullyFinishedReadGPCNVolumesDataSource     = "Successfully finished Read GPCN Volumes data source"
+	LogStartingReadGPCNVolumeTypesDataSource             = "Starting Read GPCN Volume Types data source"
+	LogSuccessfullyFinishedReadGPCNVolumeTypesDataSource = "Successfully finished Read GPCN Volume Types data source"
+
+	// List resource operation messages
+	LogStartingListGPCNVolumes             = "Starting List GPCN Volumes list resource"
+	LogSuccessfullyFinishedListGPCNVolumes = "Successfully finished List GPCN Volumes list resource"
 )
@@ -25,6 +25,20 @@ func MapVolumeResponseToModel(ctx context.Context, response *readVolumesResponse
 	model.ID = types.StringValue(response.Data.ID)
 	model.VolumeTypeId = types.Int64Value(response.Data.VolumeType.ID)
 
+	// Arguments are only unset after an import, in which case they are taken from the API
+	if model.Name.IsNull() {
+		model.Name = types.StringValue(response.Data.Name)
+	}
+	if model.DatacenterId.IsNull() {
+		model.DatacenterId = types.StringValue(response.Data.Datacenter.ID)
+	}
+	if model.VolumeType.IsNull() {
+		model.VolumeType = types.StringValue(response.Data.VolumeType.Name)
+	}
+	if model.SizeGb.IsNull() {
+		model.SizeGb = types.Int64Value(response.Data.SizeGb)
+	}
+
 	// Construct time entries
 	createdTime, err := time.Parse(time.RFC3339, response.Data.CreatedAt)
 	if err != nil {
@@ -48,3 +62,8 @@ func MapVolumeResponseToModel(ctx context.Context, response *readVolumesResponse
 
 	return model
 }
+
+// Build a model for a volume returned by the collection endpoint. Used by the list resource, where there is no plan or state
+func MapVolumeDataToModel(ctx context.Context, data readVolumesDataResponse) ResourceModel {
+	return MapVolumeResponseToModel(ctx, &readVolumesResponse{Data: data}, ResourceModel{})
+}
@@ -34,61 +34,89 @@ type volumeSizesDataVolumeTypesAvailableSizesResponse struct {
 	SizeGb int64 `json:"sizeGb"`
 }
 
-// Get volume size ID for a given datacenterId and volume type and verify typeId and sizeGb are valid
-func GetVolumeSizeId(httpClient *http.Client, ctx context.Context, datacenterId string, volumeTypeId, sizeGb int64) (int64, error) {
-	tflog.Info(ctx, fmt.Sprintf(LogStartingGetVolumeSizeIDWithParams, strconv.FormatInt(volumeTypeId, 10), strconv.FormatInt(sizeGb, 10)))
+// Lists the volume types available in a datacenter together with the sizes each of them can be provisioned with
+func ListVolumeTypes(httpClient *http.Client, ctx context.Context, datacenterId string) ([]volumeSizesDataVolumeTypesResponse, error) {
+	tflog.Info(ctx, fmt.Sprintf(LogStartingListVolumeTypesWithDatacenterID, datacenterId))
 	request, err := http.NewRequest("GET", DATA_CENTERS_BASE_URL_V1+datacenterId+"/volume-sizes", nil)
 	if err != nil {
-		return -1, err
+		return nil, err
 	}
 
 	response, err := httpClient.Do(request)
 	if err != nil {
-		return -1, err
+		return nil, err
 	}
 	defer response.Body.Close()
 
 	body, err := io.ReadAll(response.Body)
 	if err != nil {
-		return -1, err
+		return nil, err
 	}
 
 	var volumeSizesResponse volumeSizesResponse
 	err = json.Unmarshal(body, &volumeSizesResponse)
 
 	if err != nil {
-		return -1, err
+		return nil, err
 	}
 
-	tflog.Info(ctx, LogValidatingVolumeTypeAvailable)
-	// Verify the volumeType specified is available
-	typeIdx := slices.IndexFunc(volumeSizesResponse.Data.VolumeTypes, func(volumeType volumeSizesDataVolumeTypesResponse) bool {
-		return volumeType.ID == volumeTypeId
+	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyListedVolumeTypesWithDatacenterID, len(volumeSizesResponse.Data.VolumeTypes), datacenterId))
+	return volumeSizesResponse.Data.VolumeTypes, nil
+}
+
+// Find the volume type with the given name in a list of volume types, erroring with the valid names if it is not offered
+func findVolumeType(volumeTypes []volumeSizesDataVolumeTypesResponse, volumeType string) (*volumeSizesDataVolumeTypesResponse, error) {
+	typeIdx := slices.IndexFunc(volumeTypes, func(availableType volumeSizesDataVolumeTypesResponse) bool {
+		return availableType.Name == volumeType
 	})
 	if typeIdx < 0 {
-		var volumeTypes []string
-		for _, volumeType := range volumeSizesResponse.Data.VolumeTypes {
-			volumeTypes = append(volumeTypes, volumeType.Name)
+		var volumeTypeNames []string
+		for _, availableType := range volumeTypes {
+			volumeTypeNames = append(volumeTypeNames, availableType.Name)
 		}
-		volumeTypesFormatted := strings.Join(volumeTypes, ", ")
-		return -1, errors.New("the specified volume type is not available for this datacenter. Valid types are: " + volumeTypesFormatted)
+		volumeTypesFormatted := strings.Join(volumeTypeNames, ", ")
+		return nil, fmt.Errorf("%w. Valid types are: %s", ErrVolumeTypeNotAvailable, volumeTypesFormatted)
+	}
+
+	return &volumeTypes[typeIdx], nil
+}
+
+// Returned by GetVolumeSizeId when the catalog does not offer the volume type or size, to tell which attribute is invalid
+var (
+	ErrVolumeTypeNotAvailable = errors.New("the specified volume type is not available for this datacenter")
+	ErrVolumeSizeNotAvailable = errors.New("the specified volume size is not available for this datacenter")
+)
+
+// Get volume type ID and volume size ID for a given datacenterId and volume type name and verify the type and sizeGb are valid
+func GetVolumeSizeId(httpClient *http.Client, ctx context.Context, datacenterId, volumeType string, sizeGb int64) (int64, int64, error) {
+	tflog.Info(ctx, fmt.Sprintf(LogStartingGetVolumeSizeIDWithParams, volumeType, strconv.FormatInt(sizeGb, 10)))
+	volumeTypes, err := ListVolumeTypes(httpClient, ctx, datacenterId)
+	if err != nil {
+		return -1, -1, err
+	}
+
+	tflog.Info(ctx, LogValidatingVolumeTypeAvailable)
+	// Verify the volumeType specified is available
+	availableType, err := findVolumeType(volumeTypes, volumeType)
+	if err != nil {
+		return -1, -1, err
 	}
 
 	tflog.Info(ctx, LogValidatingVolumeSizeAvailable)
 	// Verify the size is available
-	sizeIdx := slices.IndexFunc(volumeSizesResponse.Data.VolumeTypes[typeIdx].AvailableSizes, func(availableSize volumeSizesDataVolumeTypesAvailableSizesResponse) bool {
+	sizeIdx := slices.IndexFunc(availableType.AvailableSizes, func(availableSize volumeSizesDataVolumeTypesAvailableSizesResponse) bool {
 		return availableSize.SizeGb == sizeGb
 	})
 	if sizeIdx < 0 {
 		var sizes []string
-		for _, size := range volumeSizesResponse.Data.VolumeTypes[typeIdx].AvailableSizes {
+		for _, size := range availableType.AvailableSizes {
 			sizes = append(sizes, strconv.FormatInt(size.SizeGb, 10))
 		}
 		sizesFormatted := strings.Join(sizes, ", ")
-		return -1, errors.New("the specified volume size is not available for this datacenter. Valid sizes are (in GB): " + sizesFormatted)
+		return -1, -1, fmt.Errorf("%w. Valid sizes are (in GB): %s", ErrVolumeSizeNotAvailable, sizesFormatted)
 	}
 
-	// If both are available, we can use the ID
-	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyRetrievedVolumeSizeIDWithParams, strconv.FormatInt(volumeTypeId, 10), strconv.FormatInt(sizeGb, 10)))
-	return volumeSizesResponse.Data.VolumeTypes[typeIdx].AvailableSizes[sizeIdx].ID, nil
+	// If both are available, we can use the IDs
+	tflog.Info(ctx, fmt.Sprintf(LogSuccessfullyRetrievedVolumeSizeIDWithParams, volumeType, strconv.FormatInt(sizeGb, 10)))
+	return availableType.ID, availableType.AvailableSizes[sizeIdx].ID, nil
 }
@@ -10,6 +10,22 @@ import (
 	"terraform-provider-gpcn/internal/client"
 )
 
+// List the IDs of the volumes attached to the virtual machine. There is no endpoint for this, so the volume list is filtered
+func ListVolumeIdsAttachedToVirtualMachine(httpClient *http.Client, ctx context.Context, virtualMachineId string) ([]string, error) {
+	allVolumes, err := ListVolumes(httpClient, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeIds := []string{}
+	for _, volume := range allVolumes {
+		if volume.VirtualMachineId == virtualMachineId {
+			volumeIds = append(volumeIds, volume.ID)
+		}
+	}
+	return volumeIds, nil
+}
+
 // Attach a volume to the virtual machine
 func AddVolumeToVirtualMachine(httpClient *http.Client, ctx context.Context, virtualMachineId, volumeId string) error {
 	attachVolumeRequestBody := map[string]string{